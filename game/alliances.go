@@ -0,0 +1,160 @@
+package game
+
+import "fmt"
+
+// LeaderChange records an ally taking over an alliance after its leader fell.
+type LeaderChange struct {
+	Cycle     int
+	Season    Season
+	Alliance  *Alliance
+	Fallen    *House
+	Successor *House
+}
+
+// ReevaluateAlliances lets houses change their minds about a war that is
+// already being fought, before each campaign. Neutral houses with a new grudge against one side may
+// join the other, while allies that have cooled on the war or are losing it
+// badly may withdraw or even betray their side.
+func (war *War) ReevaluateAlliances() {
+	// Houses that join this campaign stand by their decision until the next one.
+	attackingAllies := CopySlice(war.Attackers.Allies)
+	defendingAllies := CopySlice(war.Defenders.Allies)
+
+	sympathy := 0
+	if war.CasusBelli != nil {
+		sympathy = war.CasusBelli.Type.Sympathy
+	}
+	for _, house := range RandomizeOrder(Game.Houses) {
+		// Houses that walked away from the war won't be talked back into it.
+		if war.GetAlliance(house) != nil || war.withdrawnHouses[house] {
+			continue
+		}
+		if HouseWillJoinAlliance(house, war.Attackers, war.Defenders, war.Defenders.Allies, sympathy) {
+			war.JoinAlliance(house, war.Attackers)
+		} else if HouseWillJoinAlliance(house, war.Defenders, war.Attackers, war.Attackers.Allies, -sympathy) {
+			war.JoinAlliance(house, war.Defenders)
+		}
+	}
+
+	for _, ally := range attackingAllies {
+		if war.GetAlliance(ally) == war.Attackers {
+			war.ReconsiderAlliance(ally, war.Attackers, war.Defenders)
+		}
+	}
+	for _, ally := range defendingAllies {
+		if war.GetAlliance(ally) == war.Defenders {
+			war.ReconsiderAlliance(ally, war.Defenders, war.Attackers)
+		}
+	}
+}
+
+// JoinAlliance adds a house to a running war, bringing its vassals with it.
+func (war *War) JoinAlliance(house *House, alliance *Alliance) {
+	alliance.Allies = append(alliance.Allies, house)
+	war.CallVassals(alliance)
+	war.ChangeMorale(alliance, 1, fmt.Sprintf("%s joined the war", house.GetTitle()))
+}
+
+// LeaveAlliance takes an ally out of the war. It gives up its sieges and the
+// land it took from the enemy, and won't be talked back into the fight.
+func (war *War) LeaveAlliance(ally *House, alliance *Alliance) {
+	alliance.Allies = RemoveItem(alliance.Allies, ally)
+	war.withdrawnHouses[ally] = true
+	for _, siege := range CopySlice(war.Sieges) {
+		if siege.Besieger == ally || siege.Defender == ally {
+			war.EndSiege(siege)
+		}
+	}
+	ReturnHouseOccupations(ally, war.GetEnemyAlliance(alliance))
+}
+
+// ReconsiderAlliance weighs an ally's grudge against the enemy against its
+// doubts about the war. Doubtful allies withdraw, and those that have come to
+// resent their own leader more than the enemy may switch sides.
+func (war *War) ReconsiderAlliance(ally *House, alliance *Alliance, enemy *Alliance) {
+	withdrawalMargin := 1
+	// Honourable houses would rather walk away than stab their ally in the back.
+	betrayalMargin := Max(withdrawalMargin, 3 + GetTraitModifier(ally.Personality.Honour))
+
+	if IsBoundToAlliance(ally, alliance) {
+		return
+	}
+
+	grudge := ally.DiplomaticRelations[enemy.Leader].GetTension()
+	losingScore := Max(0, -war.GetWarScore(alliance))
+	doubts := ally.DiplomaticRelations[alliance.Leader].GetTension() + losingScore / 2
+
+	grudgeHits := RollHits(grudge)
+	doubtHits := RollHits(doubts)
+	if doubtHits <= grudgeHits + withdrawalMargin {
+		return
+	}
+
+	war.LeaveAlliance(ally, alliance)
+
+	resentsLeader := ally.DiplomaticRelations[alliance.Leader].GetTension() > grudge
+	if resentsLeader && doubtHits >= grudgeHits + betrayalMargin {
+		fmt.Printf(
+			"%s betrayed %s and turned its banners against them[%d vs %d]!\n",
+			ally.GetTitle(), alliance.Leader.GetTitle(), doubtHits, grudgeHits,
+		)
+		alliance.Leader.DiplomaticRelations[ally].AddTension(4, fmt.Sprintf("%s betrayed them in the war", ally.GetTitle()))
+		RecordGrievance(alliance.Leader, ally, Betrayal)
+		war.ChangeMorale(alliance, -3, fmt.Sprintf("%s betrayed the alliance", ally.GetTitle()))
+		war.JoinAlliance(ally, enemy)
+		return
+	}
+
+	fmt.Printf(
+		"%s lost faith in the war and withdrew from %s's alliance[%d vs %d].\n",
+		ally.GetTitle(), alliance.Leader.GetTitle(), doubtHits, grudgeHits,
+	)
+	war.ChangeMorale(alliance, -1, fmt.Sprintf("%s withdrew from the war", ally.GetTitle()))
+}
+
+// SucceedLeader replaces the fallen leader of an alliance with its mightiest
+// ally. Losing their leader shakes the alliance, and the new leader decides
+// whether the cause is still worth fighting for. The war only ends outright
+// when there are no allies left to take over.
+func (war *War) SucceedLeader(alliance *Alliance) {
+	leaderLossMorale := 2
+
+	fallen := alliance.Leader
+	enemy := war.GetEnemyAlliance(alliance)
+	if len(alliance.Allies) == 0 {
+		fmt.Printf("%s could no longer fight in the war against %s. The war is over.\n", fallen.GetTitle(), enemy.Leader.GetTitle())
+		Game.Wars = RemoveItem(Game.Wars, war)
+		return
+	}
+
+	successor := alliance.Allies[0]
+	for _, ally := range alliance.Allies {
+		if ally.GetMight() > successor.GetMight() {
+			successor = ally
+		}
+	}
+	alliance.Leader = successor
+	alliance.Allies = RemoveItem(alliance.Allies, successor)
+	war.LeaderChanges = append(war.LeaderChanges, &LeaderChange{
+		Cycle:     Game.Cycle,
+		Season:    Game.Season,
+		Alliance:  alliance,
+		Fallen:    fallen,
+		Successor: successor,
+	})
+	fmt.Printf("%s took up the leadership of the war against %s after %s fell.\n", successor.GetTitle(), enemy.Leader.GetTitle(), fallen.GetTitle())
+	war.ChangeMorale(alliance, -leaderLossMorale, fmt.Sprintf("%s fell", fallen.GetTitle()))
+
+	// The successor fights on if the alliance still has heart and it has its
+	// own quarrel with the enemy.
+	resolveHits := RollHits(Max(0, alliance.Morale) + successor.DiplomaticRelations[enemy.Leader].GetTension())
+	enemyResolveHits := RollHits(Max(0, enemy.Morale))
+	if resolveHits >= enemyResolveHits {
+		fmt.Printf("%s vowed to fight on[%d vs %d].\n", successor.GetTitle(), resolveHits, enemyResolveHits)
+		return
+	}
+
+	fmt.Printf("%s had no stomach for the war and sued for peace[%d vs %d].\n", successor.GetTitle(), resolveHits, enemyResolveHits)
+	claim := war.GetClaim(enemy)
+	war.MakePeace(enemy, alliance, ChooseTerms(war.GetWarScore(enemy), claim))
+}
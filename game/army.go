@@ -0,0 +1,75 @@
+package game
+
+import "fmt"
+
+// Army is the levies a house can put in the field. Levies lost in battle take
+// years to replace.
+type Army struct {
+	Levies int
+}
+
+// LeviesPerMight is how many levies of average quality make up one point of might.
+var LeviesPerMight = 400
+
+// GetLevyCapacity returns the most levies a house can raise. Land provides the
+// men, and wealth pays for men-at-arms on top of them.
+func GetLevyCapacity(house *House) int {
+	capacity := 0
+	for _, region := range GetHeldRegions(house) {
+		capacity += 100 * (region.Terrain.Might + 1)
+	}
+	return capacity + 50 * house.GetWealth()
+}
+
+// GetArmyQuality returns the average prowess of the knights leading the
+// house's levies. Houses without knights have poorly led levies.
+func GetArmyQuality(house *House) int {
+	if len(house.Knights) == 0 {
+		return 1
+	}
+	totalProwess := 0
+	for _, knight := range house.Knights {
+		totalProwess += knight.Prowess
+	}
+	return totalProwess / len(house.Knights)
+}
+
+// GetArmyStrength returns the levies of the house weighted by the quality of their leaders.
+func GetArmyStrength(house *House) int {
+	// A quality of 3 is average and leaves the levies unchanged.
+	return house.Army.Levies * (GetArmyQuality(house) + 2) / 5
+}
+
+func NewArmy(house *House) *Army {
+	return &Army{
+		Levies: GetLevyCapacity(house),
+	}
+}
+
+// RecruitLevies replenishes each house's levies from its land. Houses at war
+// have fewer spare hands and recover more slowly. Levies from land that has
+// been lost go home.
+func RecruitLevies() {
+	recoveryYears := 4
+
+	for _, house := range Game.Houses {
+		capacity := GetLevyCapacity(house)
+		recruits := capacity / recoveryYears
+		if house.NumWars() > 0 {
+			recruits /= 2
+		}
+		house.Army.Levies = Min(house.Army.Levies + recruits, capacity)
+	}
+}
+
+// InflictCasualties kills a percentage of each house's levies.
+func InflictCasualties(houses []*House, percentage int) {
+	for _, house := range houses {
+		casualties := house.Army.Levies * percentage / 100
+		if casualties == 0 {
+			continue
+		}
+		house.Army.Levies -= casualties
+		fmt.Printf("%s lost %d levies[%d remaining].\n", house.GetTitle(), casualties, house.Army.Levies)
+	}
+}
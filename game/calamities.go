@@ -0,0 +1,207 @@
+package game
+
+import "fmt"
+
+// CharityCost is the coin the church spends easing a house's suffering.
+var CharityCost = 10
+
+// CharityGlory is the glory the church earns for its charity.
+var CharityGlory = 15
+
+// CalamityType is a disaster, or a blessing, that befalls a house's lands and
+// stays with it for years.
+type CalamityType struct {
+	Name string
+	// Description is announced when the calamity strikes a house.
+	Description string
+	Years       int
+
+	WealthModifier int
+	MightModifier  int
+	// MoraleDrain is the morale lost each year by every alliance the house fights for.
+	MoraleDrain int
+	// DeathChance is the 1 in N chance each knight of the house dies when it strikes.
+	DeathChance int
+}
+
+var Plague = &CalamityType {
+	Name:           "plague",
+	Description:    "A plague swept through the lands of %s.",
+	Years:          3,
+	WealthModifier: -1,
+	DeathChance:    4,
+}
+
+var Famine = &CalamityType {
+	Name:        "famine",
+	Description: "The crops failed and famine struck the lands of %s.",
+	Years:       3,
+	MoraleDrain: 1,
+}
+
+var Flood = &CalamityType {
+	Name:          "flood",
+	Description:   "The rivers burst their banks and flooded the lands of %s.",
+	Years:         3,
+	MightModifier: -1,
+}
+
+var Fire = &CalamityType {
+	Name:          "fire",
+	Description:   "A great fire burned through the towns of %s.",
+	Years:         2,
+	MightModifier: -1,
+}
+
+var GoodHarvest = &CalamityType {
+	Name:           "good harvest",
+	Description:    "The gods blessed the fields of %s with a bountiful harvest.",
+	Years:          2,
+	WealthModifier: 1,
+}
+
+// Calamity is a calamity that has struck a house.
+type Calamity struct {
+	Type       *CalamityType
+	House      *House
+	StartCycle int
+}
+
+func (calamity *Calamity) GetEndCycle() int {
+	return calamity.StartCycle + calamity.Type.Years
+}
+
+// IsHarmful returns whether the calamity is one the church can relieve.
+func (calamity *Calamity) IsHarmful() bool {
+	return calamity.Type.WealthModifier < 0 || calamity.Type.MightModifier < 0 || calamity.Type.MoraleDrain > 0
+}
+
+// GetCalamities returns the calamities currently afflicting a house.
+func GetCalamities(house *House) []*Calamity {
+	calamities := make([]*Calamity, 0)
+	for _, calamity := range Game.Calamities {
+		if calamity.House == house {
+			calamities = append(calamities, calamity)
+		}
+	}
+	return calamities
+}
+
+// GetCalamityWealthModifier returns how much the house's calamities change its wealth.
+func GetCalamityWealthModifier(house *House) int {
+	modifier := 0
+	for _, calamity := range GetCalamities(house) {
+		modifier += calamity.Type.WealthModifier
+	}
+	return modifier
+}
+
+// GetCalamityMightModifier returns how much the house's calamities change its might.
+func GetCalamityMightModifier(house *House) int {
+	modifier := 0
+	for _, calamity := range GetCalamities(house) {
+		modifier += calamity.Type.MightModifier
+	}
+	return modifier
+}
+
+// CalamityEvent strikes a random house with a calamity. A house is only
+// struck by each kind of calamity once at a time.
+func CalamityEvent(calamityType *CalamityType) {
+	house := RandomSelect(Game.Houses)
+	for _, calamity := range GetCalamities(house) {
+		if calamity.Type == calamityType {
+			return
+		}
+	}
+
+	Game.Calamities = append(Game.Calamities, &Calamity{
+		Type:       calamityType,
+		House:      house,
+		StartCycle: Game.Cycle,
+	})
+	fmt.Printf(calamityType.Description + "\n", house.GetTitle())
+
+	if calamityType.DeathChance == 0 {
+		return
+	}
+	for _, knight := range GetSwornKnights(house) {
+		if RandomRange(0, calamityType.DeathChance) == 0 {
+			fmt.Printf("%s died of the %s.\n", knight.GetTitle(), calamityType.Name)
+			KillKnight(knight)
+		}
+	}
+}
+
+// UpdateCalamities has calamities take their yearly toll, and ends those that
+// have run their course.
+func UpdateCalamities() {
+	for _, calamity := range CopySlice(Game.Calamities) {
+		house := calamity.House
+		if !Exists(Game.Houses, house) || Game.Cycle >= calamity.GetEndCycle() {
+			Game.Calamities = RemoveItem(Game.Calamities, calamity)
+			if Exists(Game.Houses, house) {
+				fmt.Printf("The %s in the lands of %s has passed.\n", calamity.Type.Name, house.GetTitle())
+			}
+			continue
+		}
+
+		if calamity.Type.MoraleDrain == 0 {
+			continue
+		}
+		for _, war := range Game.Wars {
+			if alliance := war.GetAlliance(house); alliance != nil {
+				war.ChangeMorale(alliance, -calamity.Type.MoraleDrain, fmt.Sprintf("%s suffered %s", house.GetTitle(), calamity.Type.Name))
+			}
+		}
+	}
+}
+
+// GiveCharity has the church ease the worst of a house's suffering, ending a
+// calamity. The grateful house grows more pious and the church earns glory.
+func GiveCharity(house *House) {
+	for _, calamity := range GetCalamities(house) {
+		if !calamity.IsHarmful() {
+			continue
+		}
+		if CharityCost > Game.Player.Coin {
+			fmt.Printf("Giving charity costs %d coin, you only have %d.\n", CharityCost, Game.Player.Coin)
+			return
+		}
+
+		Game.Player.Coin -= CharityCost
+		Game.Player.Glory += CharityGlory
+		Game.Calamities = RemoveItem(Game.Calamities, calamity)
+		house.Personality.Piety = Min(house.Personality.Piety + 1, MaxTrait)
+		fmt.Printf(
+			"The church's charity eased the %s in the lands of %s, earning %d glory. %s grew more pious[piety: %d].\n",
+			calamity.Type.Name, house.GetTitle(), CharityGlory, house.GetTitle(), house.Personality.Piety,
+		)
+		return
+	}
+	fmt.Printf("%s is not suffering any calamities\n", house.GetTitle())
+}
+
+// DisplayCalamities lists the calamities afflicting the realm.
+func DisplayCalamities() {
+	if len(Game.Calamities) == 0 {
+		fmt.Printf("The realm is free of calamities.\n")
+		return
+	}
+	for _, calamity := range Game.Calamities {
+		effects := ""
+		if calamity.Type.WealthModifier != 0 {
+			effects += fmt.Sprintf(", wealth: %+d", calamity.Type.WealthModifier)
+		}
+		if calamity.Type.MightModifier != 0 {
+			effects += fmt.Sprintf(", might: %+d", calamity.Type.MightModifier)
+		}
+		if calamity.Type.MoraleDrain != 0 {
+			effects += fmt.Sprintf(", war morale: %+d a year", -calamity.Type.MoraleDrain)
+		}
+		fmt.Printf(
+			"The %s in the lands of %s began in year %d[until year %d%s].\n",
+			calamity.Type.Name, calamity.House.GetTitle(), calamity.StartCycle, calamity.GetEndCycle(), effects,
+		)
+	}
+}
@@ -0,0 +1,67 @@
+package game
+
+import "fmt"
+
+type Season = int
+const (
+	Spring Season = iota
+	Summer
+	Autumn
+	Winter
+)
+
+var Seasons = []Season{Spring, Summer, Autumn, Winter}
+
+var SeasonNames = map[Season]string{
+	Spring: "Spring",
+	Summer: "Summer",
+	Autumn: "Autumn",
+	Winter: "Winter",
+}
+
+// OffSeasonCampaignChance is the 1 in N chance a war is fought in spring or
+// autumn. Armies march in earnest in summer, and not at all in winter.
+var OffSeasonCampaignChance = 3
+
+// WinterAttritionPercentage is the percentage of their levies besiegers lose
+// to cold and sickness each winter.
+var WinterAttritionPercentage = 10
+
+// GetDateText returns the current year and season, e.g. "Year 4, Autumn".
+func GetDateText() string {
+	return FormatDate(Game.Cycle, Game.Season)
+}
+
+func FormatDate(cycle int, season Season) string {
+	return fmt.Sprintf("Year %d, %s", cycle, SeasonNames[season])
+}
+
+// IsCampaigning returns whether a war's armies take the field this season.
+func IsCampaigning() bool {
+	if Game.Season == Summer {
+		return true
+	} else if Game.Season == Winter {
+		return false
+	}
+	return RandomRange(0, OffSeasonCampaignChance) == 0
+}
+
+// DoWinterAttrition has winter take its toll on every army camped outside an
+// enemy's walls.
+func (war *War) DoWinterAttrition() {
+	for _, siege := range CopySlice(war.Sieges) {
+		besiegers := war.GetAlliance(siege.Besieger)
+		if besiegers == nil || !Exists(Game.Houses, siege.Besieger) {
+			continue
+		}
+
+		fmt.Printf("Winter set in around the siege of %s.\n", siege.Defender.GetTitle())
+		InflictCasualties([]*House{siege.Besieger}, WinterAttritionPercentage)
+		siege.Morale--
+		if siege.Morale <= 0 {
+			fmt.Printf("%s lost heart in the cold and lifted the siege of %s.\n", siege.Besieger.GetTitle(), siege.Defender.GetTitle())
+			war.ChangeMorale(besiegers, -1, fmt.Sprintf("the siege of %s was lifted", siege.Defender.GetTitle()))
+			war.EndSiege(siege)
+		}
+	}
+}
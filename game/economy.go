@@ -0,0 +1,112 @@
+package game
+
+import "fmt"
+
+// CoinPerWealth is how much coin a house needs in its coffers to raise its
+// wealth by one above what its land provides.
+var CoinPerWealth = 30
+
+// GetLandIncome returns the coin a house's held regions yield each year.
+func GetLandIncome(house *House) int {
+	income := 0
+	for _, region := range GetHeldRegions(house) {
+		income += region.Terrain.Wealth
+	}
+	return income
+}
+
+// GetTradingPartners returns the houses bordering this one that it is at
+// peace with. Merchants won't cross a border while armies are marching.
+func GetTradingPartners(house *House) []*House {
+	partners := make([]*House, 0)
+	for _, region := range GetHeldRegions(house) {
+		for _, neighbour := range region.Neighbours {
+			partner := neighbour.GetController()
+			if partner == nil || partner == house || Exists(partners, partner) {
+				continue
+			}
+			if !HousesAreAtWar(house, partner) {
+				partners = append(partners, partner)
+			}
+		}
+	}
+	return partners
+}
+
+func GetTradeIncome(house *House) int {
+	return len(GetTradingPartners(house))
+}
+
+func GetIncome(house *House) int {
+	return GetLandIncome(house) + GetTradeIncome(house) + GetAgreementIncome(house)
+}
+
+func GetKnightUpkeep(house *House) int {
+	return len(GetSwornKnights(house)) / 3
+}
+
+func GetArmyUpkeep(house *House) int {
+	leviesPerCoin := 500
+	return house.Army.Levies / leviesPerCoin
+}
+
+// WarCostPerYear is the coin a house spends a year on each war it fights.
+var WarCostPerYear = 2
+
+// GetWarCosts returns the coin a house spends keeping its armies in the
+// field. Every war is a drain on the coffers.
+func GetWarCosts(house *House) int {
+	return WarCostPerYear * house.NumWars()
+}
+
+func GetUpkeep(house *House) int {
+	return GetKnightUpkeep(house) + GetArmyUpkeep(house) + GetWarCosts(house)
+}
+
+// CollectHouseIncome fills each house's coffers from its land, its neighbours
+// and its trade agreements.
+func CollectHouseIncome() {
+	for _, house := range Game.Houses {
+		house.Coin += GetIncome(house)
+	}
+}
+
+// PayUpkeep has every house pay for its knights, levies and wars. Houses that
+// can't pay go bankrupt. Any change in a house's wealth over the year is
+// announced.
+func PayUpkeep() {
+	for _, house := range CopySlice(Game.Houses) {
+		upkeep := GetUpkeep(house)
+		house.Coin -= upkeep
+		if house.Coin < 0 {
+			GoBankrupt(house, -house.Coin)
+			house.Coin = 0
+		}
+
+		wealth := house.GetWealth()
+		if house.lastWealth != 0 && wealth > house.lastWealth {
+			fmt.Printf("%s has prospered, its wealth rose to %d.\n", house.GetTitle(), wealth)
+		} else if house.lastWealth != 0 && wealth < house.lastWealth {
+			fmt.Printf("%s has fallen on hard times, its wealth dropped to %d.\n", house.GetTitle(), wealth)
+		}
+		house.lastWealth = wealth
+	}
+}
+
+// GoBankrupt punishes a house that couldn't pay its upkeep. Unpaid levies go
+// home, sellswords leave and knights may abandon the house for the road.
+func GoBankrupt(house *House, debt int) {
+	levyLossPercentage := 20
+
+	fmt.Printf("%s could not pay %d coin of its upkeep and went bankrupt!\n", house.GetTitle(), debt)
+	InflictCasualties([]*House{house}, levyLossPercentage)
+	ReleaseAllSellswords(house)
+
+	// The house's own knights stay as long as they can be fed.
+	swornKnights := GetSwornKnights(house)
+	if len(swornKnights) > 1 && RollHits(debt) > 0 {
+		knight := RandomSelect(swornKnights)
+		fmt.Printf("%s left %s to seek their fortune on the road.\n", knight.GetTitle(), house.GetTitle())
+		DesertKnight(knight)
+	}
+}
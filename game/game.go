@@ -9,16 +9,19 @@ import (
 var MaxMight = 5
 var MaxWealth = 5
 
-type DiplomaticRelation struct {
-	Tension int
-}
-
 type Banner struct {
 	Symbol string
 	Color string
 	Adjective string
 }
 
+func (banner *Banner) GetColourCode() string {
+	if colourCode, found := BannerColourCodes[banner.Color]; found {
+		return colourCode
+	}
+	return DefaultColourCode
+}
+
 func (banner *Banner) GetDescription() string {
 	var description string
 	if banner.Adjective == "" {
@@ -40,15 +43,27 @@ func (banner *Banner) GetDescription() string {
 type House struct {
 	Name string
 	Banner Banner
+	Personality Personality
 
-	/**
-	 * Make these values getters so they can be derived from other systems in the future
-	 * if necessary.
-	 */
-	Might int
-	Wealth int
+	// Coin is the house's coffers, topped up each year by its land and trade.
+	Coin int
+	// lastWealth is the wealth of the house when it last paid its upkeep.
+	lastWealth int
+
+	Castle *Castle
+	Army   *Army
+
+	// Liege is the house this house has sworn fealty to, if any.
+	Liege *House
+	// Lord is the knight at the head of the house.
+	Lord *Knight
+	// Claimant is a knight of the house disputing the lord's right to rule.
+	Claimant *Knight
+	// Infamy is how many times the house has attacked a house far weaker than itself.
+	Infamy int
 
 	Knights []*Knight
+	Prisoners []*Knight
 	DiplomaticRelations map[*House]*DiplomaticRelation
 }
 
@@ -73,9 +88,21 @@ func (house *House) NumWars() int {
 	return numWars
 }
 
+// GetMight returns a 1 to MaxMight rating of the house's current army.
+func (house *House) GetMight() int {
+	return Max(1, Min(GetArmyStrength(house) / LeviesPerMight + 1 + GetCalamityMightModifier(house), MaxMight))
+}
+
+// GetWealth returns the house's wealth, derived from the land it holds and
+// the coin it has saved.
+func (house *House) GetWealth() int {
+	landWealth := (GetLandIncome(house) + 1) / 2
+	return Max(1, Min(landWealth + house.Coin / CoinPerWealth + GetCalamityWealthModifier(house), MaxWealth))
+}
+
 func (house *House) GetAdjustedMight() int {
 	// Reduce might for each extra war the house is in.
-	return house.Might - Max[int](0, house.NumWars() - 1)
+	return house.GetMight() - Max[int](0, house.NumWars() - 1)
 }
 
 // GloryBishop is a member of the church who sponsors knights for glory.
@@ -92,16 +119,33 @@ type GameState struct {
 	Knights []*Knight
 	Houses []*House
 	Wars []*War
+	// WarHistory is every war ever declared, including those still being fought.
+	WarHistory []*War
+	Treaties []*Treaty
+	TradeAgreements []*TradeAgreement
+	Calamities []*Calamity
+	MercenaryCompanies []*MercenaryCompany
+	Tournaments []*Tournament
+	Map *WorldMap
+
+	// WarPolicy is how houses decide whether to go to war.
+	WarPolicy WarPolicy
 
 	FemaleNameGenerator names.NameGenerator
 	MaleNameGenerator names.NameGenerator
 
+	// Cycle is the current year.
 	Cycle int
+	Season Season
 }
 
 func AssignKnightToHouse(knight *Knight, house *House) {
 	house.Knights = append(house.Knights, knight)
 	knight.House = house
+	// The first knight of a house without a lord takes up the lordship.
+	if house.Lord == nil {
+		house.Lord = knight
+	}
 }
 
 func SponsorKnight(bishop *GloryBishop, knight *Knight) {
@@ -110,40 +154,74 @@ func SponsorKnight(bishop *GloryBishop, knight *Knight) {
 }
 
 func DestroyHouse(destroyedHouse *House) {
+	// The house leaves the realm before its wars are settled, so houses that
+	// rise in its wake don't form relations with it.
+	ReleaseLand(destroyedHouse)
+	Game.Houses = RemoveItem(Game.Houses, destroyedHouse)
 	for _, house := range Game.Houses {
 		delete(house.DiplomaticRelations, destroyedHouse)
 	}
 	for _, war := range CopySlice(Game.Wars) {
-		// End the war if the destroyedHouse is a primary fighter.
-		if war.Attackers.Leader == destroyedHouse {
-			fmt.Printf("%s could no longer fight in the war against %s. The war is over.\n", destroyedHouse.GetTitle(), war.Defenders.Leader.GetTitle())
-			Game.Wars = RemoveItem(Game.Wars, war)
-		}
-		if war.Defenders.Leader == destroyedHouse {
-			fmt.Printf("%s could no longer fight in the war against %s. The war is over.\n", destroyedHouse.GetTitle(), war.Attackers.Leader.GetTitle())
-			Game.Wars = RemoveItem(Game.Wars, war)
+		// Another house may have fallen while succeeding a leader and ended this war.
+		if !Exists(Game.Wars, war) {
+			continue
 		}
 
 		// If the destroyedHouse was just an ally, remove them from the allies.
 		war.Attackers.Allies = RemoveItem(war.Attackers.Allies, destroyedHouse)
 		war.Defenders.Allies = RemoveItem(war.Defenders.Allies, destroyedHouse)
+
+		// If the destroyedHouse was a primary fighter an ally must take up the cause.
+		if war.Attackers.Leader == destroyedHouse {
+			war.SucceedLeader(war.Attackers)
+		} else if war.Defenders.Leader == destroyedHouse {
+			war.SucceedLeader(war.Defenders)
+		}
+	}
+	for _, treaty := range CopySlice(Game.Treaties) {
+		if treaty.IsParty(destroyedHouse) {
+			EndTreaty(treaty)
+		}
+	}
+	for _, agreement := range GetTradeAgreements(destroyedHouse) {
+		Game.TradeAgreements = RemoveItem(Game.TradeAgreements, agreement)
+	}
+	for _, house := range Game.Houses {
+		if house.Liege == destroyedHouse {
+			house.Liege = nil
+		}
+	}
+	// Sellswords survive their employer and go back to wandering.
+	ReleaseAllSellswords(destroyedHouse)
+	for _, prisoner := range CopySlice(destroyedHouse.Prisoners) {
+		ReleasePrisoner(prisoner)
 	}
 	for _, knight := range destroyedHouse.Knights {
 		Game.Knights = RemoveItem(Game.Knights, knight)
 	}
-	Game.Houses = RemoveItem(Game.Houses, destroyedHouse)
+	// Knights held captive are stripped of their nobility along with the rest of their house.
+	for _, knight := range CopySlice(Game.Knights) {
+		if knight.House == destroyedHouse && knight.Captor != nil {
+			knight.Captor.Prisoners = RemoveItem(knight.Captor.Prisoners, knight)
+			Game.Knights = RemoveItem(Game.Knights, knight)
+		}
+	}
 }
 
 func GenerateHouse() *House {
 	house := &House{
 		Name:   Game.FemaleNameGenerator.GenerateName(),
 		Banner: GenerateBanner(),
-		Might:  RandomRange(1, MaxMight + 1),
-		// TODO: Make wealth related to might of house in some way?
-		Wealth: RandomRange(1, MaxWealth + 1),
+		Personality: GeneratePersonality(),
+		Castle: &Castle{
+			Fortification: RandomRange(1, 4),
+		},
 		DiplomaticRelations: make(map[*House]*DiplomaticRelation, 0),
 	}
 	Game.Houses = append(Game.Houses, house)
+	ClaimLand(house, RandomRange(3, 6))
+	house.Coin = 5 * house.GetWealth()
+	house.Army = NewArmy(house)
 	InitNewDiplomaticRelations()
 	return house
 }
@@ -161,7 +239,7 @@ func InitNewDiplomaticRelations() {
 			}
 
 			srcHouse.DiplomaticRelations[dstHouse] = &DiplomaticRelation{
-				Tension: 0,
+				Incidents: make([]*Incident, 0),
 			}
 		}
 	}
@@ -170,6 +248,12 @@ func InitNewDiplomaticRelations() {
 func GenerateWorld() {
 	numHouses := 6
 	numKnights := 10
+	numHedgeKnights := 3
+	numMercenaryCompanies := 1
+	mapWidth := 8
+	mapHeight := 6
+
+	Game.Map = GenerateMap(mapWidth, mapHeight)
 
 	// Generate houses.
 	Game.Houses = make([]*House, 0, 5)
@@ -182,9 +266,20 @@ func GenerateWorld() {
 	for idx := 0; idx < numKnights; idx++ {
 		GenerateKnight(RandomSelect(Game.Houses))
 	}
+
+	// Generate landless knights.
+	for idx := 0; idx < numHedgeKnights; idx++ {
+		GenerateKnight(nil)
+	}
+	Game.MercenaryCompanies = make([]*MercenaryCompany, 0, numMercenaryCompanies)
+	for idx := 0; idx < numMercenaryCompanies; idx++ {
+		GenerateMercenaryCompany()
+	}
 }
 
-func GenerateKnight(house *House) {
+// GenerateKnight creates a new knight in the given house. Passing a nil house
+// creates a hedge knight.
+func GenerateKnight(house *House) *Knight {
 	gender := RandomSelect([]Gender{Female, Male})
 	var name string
 	if gender == Female {
@@ -202,6 +297,7 @@ func GenerateKnight(house *House) {
 	)
 	// TODO: Should go in knight constructor?
 	Game.Knights = append(Game.Knights, knight)
+	return knight
 }
 
 func GenerateBanner() Banner {
@@ -260,15 +356,18 @@ func RollHits(rating int) int {
 	return successes
 }
 
-func ChooseHouseChampion(house *House) *Knight {
+func ChooseChampion(knights []*Knight, excludedKnights []*Knight) *Knight {
 	/**
-	 * Choose a champion for the house by rolling the bravery of all
-	 * knights and choosing the bravest. Prowess is used as a tie
-	 * breaker.
+	 * Choose a champion by rolling the bravery of all knights and
+	 * choosing the bravest. Prowess is used as a tie breaker. Knights
+	 * already fighting on another front are excluded.
 	 */
 	maxBraveryHits := -1
 	var bravestKnight *Knight = nil
-	for _, knight := range house.Knights {
+	for _, knight := range knights {
+		if Exists(excludedKnights, knight) {
+			continue
+		}
 		braveryHits := RollHits(knight.Bravery)
 		if braveryHits > maxBraveryHits {
 			maxBraveryHits = braveryHits
@@ -282,28 +381,22 @@ func ChooseHouseChampion(house *House) *Knight {
 	return bravestKnight
 }
 
-// Returns the margin of the attacker. This will be <=0 if they lost
-// and >0 if they won.
-func RunBattle(attackingHouse *House, defendingHouse *House) int {
-	// TODO: Reduce morale for every knight killed?
-	fmt.Printf("%s attacks %s!\n", attackingHouse.GetTitle(), defendingHouse.GetTitle())
-
+// RunDuel has the champions of two sides fight on one front of a battle. The
+// losing champion is killed or captured. Returns the advantage each house
+// gained from the duel. Either knight may be nil if their side couldn't
+// field a champion.
+func RunDuel(attackingKnight *Knight, defendingKnight *Knight, attackingTitle string, defendingTitle string) (int, int) {
 	attackerAdvantage := 0
 	defenderAdvantage := 0
 
-	// NOTE: Maybe battles could have multiple "fronts" and we'd have a champion per front.
-	// number of fronts could depend on the terrain or some other factor?
-	attackingKnight := ChooseHouseChampion(attackingHouse)
-	defendingKnight := ChooseHouseChampion(defendingHouse)
-
 	if attackingKnight == nil && defendingKnight == nil {
-		fmt.Printf("Neither house could field a champion!\n")
+		fmt.Printf("Neither side could field a champion!\n")
 	} else if attackingKnight == nil {
 		defenderAdvantage = 1
-		fmt.Printf("%s could not field a champion, giving %s a tactical edge!\n", attackingHouse.GetTitle(), defendingHouse.GetTitle())
+		fmt.Printf("%s could not field a champion, giving %s a tactical edge!\n", attackingTitle, defendingTitle)
 	} else if defendingKnight == nil {
 		attackerAdvantage = 1
-		fmt.Printf("%s could not field a champion, giving %s a tactical edge!\n", defendingHouse.GetTitle(), attackingHouse.GetTitle())
+		fmt.Printf("%s could not field a champion, giving %s a tactical edge!\n", defendingTitle, attackingTitle)
 	} else {
 		attackerHits := RollHits(attackingKnight.Prowess + attackingKnight.Blessings)
 		defenderHits := RollHits(defendingKnight.Prowess + defendingKnight.Blessings)
@@ -311,8 +404,6 @@ func RunBattle(attackingHouse *House, defendingHouse *House) int {
 		var winner, loser *Knight
 		var winnerHits, loserHits int
 
-		// TODO: Split up duels and battles into their own functions.
-		// TODO: Maybe only kill if the margin is big enough.
 		if attackerHits == defenderHits {
 			fmt.Printf(
 				"%s met %s on the battlefield, their duel raged until it met a stalemate[%d/%dd+%dd vs %d/%dd+%dd]!\n",
@@ -331,12 +422,19 @@ func RunBattle(attackingHouse *House, defendingHouse *House) int {
 				winnerHits, loserHits = defenderHits, attackerHits
 			}
 
+			isCaptured := ShouldCaptureKnight(winner, loser, winnerHits - loserHits)
+			var duelMessage string
+			if isCaptured {
+				duelMessage = fmt.Sprintf("%s forced %s to yield", winner.GetTitle(), loser.GetTitle())
+			} else {
+				duelMessage = winner.Weapon.GetKillMessage(winner, loser)
+			}
 			fmt.Printf(
 				"%s after an intense duel[%d/%dd+%dd vs %d/%dd+%dd], giving %s a tactical edge!\n",
-				winner.Weapon.GetKillMessage(winner, loser),
+				duelMessage,
 				winnerHits, winner.Prowess, winner.Blessings,
 				loserHits, loser.Prowess, loser.Blessings,
-				winner.House.GetTitle(),
+				winner.GetAllegiance().GetTitle(),
 			)
 
 			if winner.Sponsor != nil {
@@ -345,8 +443,14 @@ func RunBattle(attackingHouse *House, defendingHouse *House) int {
 				fmt.Printf("The Church earned %d glory for sponsoring %s.\n", glory, winner.GetTitle())
 			}
 
-			KillKnight(loser)
-			winner.SlayedKnights = append(winner.SlayedKnights, loser)
+			if isCaptured {
+				captor := winner.GetAllegiance()
+				fmt.Printf("%s was taken prisoner by %s.\n", loser.GetTitle(), captor.GetTitle())
+				CaptureKnight(captor, loser)
+			} else {
+				KillKnight(loser)
+				winner.SlayedKnights = append(winner.SlayedKnights, loser)
+			}
 		}
 	}
 
@@ -356,27 +460,138 @@ func RunBattle(attackingHouse *House, defendingHouse *House) int {
 		defendingKnight.Blessings = 0
 	}
 
-	attackerHits := RollHits(attackingHouse.GetAdjustedMight() + attackerAdvantage)
-	defenderHits := RollHits(defendingHouse.GetAdjustedMight() + defenderAdvantage)
+	return attackerAdvantage, defenderAdvantage
+}
+
+// GetCoalitionTitle lists the titles of all houses fighting together in a battle.
+func GetCoalitionTitle(houses []*House) string {
+	titles := make([]string, 0, len(houses))
+	for _, house := range houses {
+		titles = append(titles, house.GetTitle())
+	}
+	if len(titles) == 1 {
+		return titles[0]
+	}
+	return fmt.Sprintf("%s and %s", strings.Join(titles[:len(titles) - 1], ", "), titles[len(titles) - 1])
+}
+
+func GetCoalitionKnights(houses []*House) []*Knight {
+	knights := make([]*Knight, 0)
+	for _, house := range houses {
+		knights = append(knights, house.Knights...)
+	}
+	return knights
+}
+
+func GetCoalitionMight(houses []*House) int {
+	might := 0
+	for _, house := range houses {
+		might += house.GetAdjustedMight()
+	}
+	return might
+}
+
+// RunBattle fights a battle between two coalitions of houses. The first house
+// of each coalition leads it, the rest are allies that answered the call.
+// Defenders fighting from behind walls add the fortification to their might.
+func RunBattle(attackingHouses []*House, defendingHouses []*House, fortification int) *BattleReport {
+	report := &BattleReport{}
+	attackingKnightsBefore := GetCoalitionKnights(attackingHouses)
+	defendingKnightsBefore := GetCoalitionKnights(defendingHouses)
+
+	attackingHouse := attackingHouses[0]
+	defendingHouse := defendingHouses[0]
+	attackingTitle := GetCoalitionTitle(attackingHouses)
+	defendingTitle := GetCoalitionTitle(defendingHouses)
+
+	attackVerb := "attacks"
+	if len(attackingHouses) > 1 {
+		attackVerb = "attack"
+	}
+
+	battlefield := ChooseBattlefield(attackingHouse, defendingHouse)
+	if battlefield != nil {
+		fmt.Printf("%s %s %s at %s!\n", attackingTitle, attackVerb, defendingTitle, battlefield.Name)
+	} else {
+		fmt.Printf("%s %s %s!\n", attackingTitle, attackVerb, defendingTitle)
+	}
+
+	// Each front of the battle is fought by its own champions. Rougher terrain
+	// leaves room for fewer fronts.
+	numFronts := 1
+	if battlefield != nil {
+		numFronts = battlefield.Terrain.Fronts
+	}
+
+	attackerAdvantage := 0
+	defenderAdvantage := 0
+	attackingChampions := make([]*Knight, 0, numFronts)
+	defendingChampions := make([]*Knight, 0, numFronts)
+	for front := 0; front < numFronts; front++ {
+		attackingKnight := ChooseChampion(GetCoalitionKnights(attackingHouses), attackingChampions)
+		defendingKnight := ChooseChampion(GetCoalitionKnights(defendingHouses), defendingChampions)
+		// Once both sides run out of knights there's nobody left to fight the other fronts.
+		if attackingKnight == nil && defendingKnight == nil && front > 0 {
+			break
+		}
+		if attackingKnight != nil {
+			attackingChampions = append(attackingChampions, attackingKnight)
+		}
+		if defendingKnight != nil {
+			defendingChampions = append(defendingChampions, defendingKnight)
+		}
+
+		frontAttackerAdvantage, frontDefenderAdvantage := RunDuel(
+			attackingKnight, defendingKnight, attackingTitle, defendingTitle,
+		)
+		attackerAdvantage += frontAttackerAdvantage
+		defenderAdvantage += frontDefenderAdvantage
+	}
+	report.AttackerDuelWins = attackerAdvantage
+	report.DefenderDuelWins = defenderAdvantage
+
+	attackingMight := GetCoalitionMight(attackingHouses)
+	defendingMight := GetCoalitionMight(defendingHouses) + fortification
+	attackerHits := RollHits(attackingMight + attackerAdvantage)
+	defenderHits := RollHits(defendingMight + defenderAdvantage)
 
-	var winner, loser *House
+	var winners, losers []*House
 	var winnerHits, loserHits int
+	var winnerMight, loserMight int
 
 	if attackerHits > defenderHits {
-		winner, winnerHits, loser, loserHits = attackingHouse, attackerHits, defendingHouse, defenderHits
+		winners, winnerHits, winnerMight = attackingHouses, attackerHits, attackingMight
+		losers, loserHits, loserMight = defendingHouses, defenderHits, defendingMight
 	} else {
-		winner, winnerHits, loser, loserHits = defendingHouse, defenderHits, attackingHouse, attackerHits
+		winners, winnerHits, winnerMight = defendingHouses, defenderHits, defendingMight
+		losers, loserHits, loserMight = attackingHouses, attackerHits, attackingMight
 	}
 	// TODO: Print advantages?
 	fmt.Printf(
 		"%s[%d/%dd hits] defeated %s[%d/%dd hits]!\n",
-		winner.GetTitle(), winnerHits, winner.GetAdjustedMight(), loser.GetTitle(), loserHits, loser.GetAdjustedMight(),
+		GetCoalitionTitle(winners), winnerHits, winnerMight, GetCoalitionTitle(losers), loserHits, loserMight,
 	)
 
+	// Routed armies suffer far heavier losses than the victors.
+	winnerCasualtyPercentage := 5
+	loserCasualtyPercentage := Min(50, 10 * (winnerHits - loserHits + 1))
+	InflictCasualties(winners, winnerCasualtyPercentage)
+	InflictCasualties(losers, loserCasualtyPercentage)
+
+	// The attacker takes the battlefield if they win, otherwise the defender
+	// pushes them back out of their lands.
+	if winners[0] == attackingHouse && battlefield != nil {
+		OccupyRegion(attackingHouse, battlefield)
+	} else if winners[0] == defendingHouse {
+		for _, house := range attackingHouses {
+			LiberateRegion(defendingHouse, house)
+		}
+	}
+
 	// TODO: Remove glory for winning battle? Too easy?
 	// Award more glory to underdogs and less to bullies.
-	glory := (MaxMight + 1) + (loser.Might - winner.Might)
-	for _, knight := range winner.Knights {
+	glory := Max(1, (MaxMight + 1) + (loserMight - winnerMight))
+	for _, knight := range GetCoalitionKnights(winners) {
 		knight.BattleResults = append(knight.BattleResults, Victory)
 		if knight.Sponsor != nil {
 			Game.Player.Glory += glory
@@ -384,9 +599,8 @@ func RunBattle(attackingHouse *House, defendingHouse *House) int {
 		}
 	}
 
-	loserKnightsCopy := make([]*Knight, len(loser.Knights))
-	copy(loserKnightsCopy, loser.Knights)
-	for _, knight := range loserKnightsCopy {
+	// Every knight of the losing coalition risks being cut down in the rout.
+	for _, knight := range GetCoalitionKnights(losers) {
 		knight.BattleResults = append(knight.BattleResults, Defeat)
 
 		defeatSeverity := (winnerHits - loserHits) / 2
@@ -400,7 +614,21 @@ func RunBattle(attackingHouse *House, defendingHouse *House) int {
 		}
 	}
 
-	return attackerHits - defenderHits
+	report.AttackerMargin = attackerHits - defenderHits
+	report.AttackerDeaths = CountDeadKnights(attackingKnightsBefore)
+	report.DefenderDeaths = CountDeadKnights(defendingKnightsBefore)
+	return report
+}
+
+// CountDeadKnights returns how many of the knights are no longer alive.
+func CountDeadKnights(knights []*Knight) int {
+	numDead := 0
+	for _, knight := range knights {
+		if !Exists(Game.Knights, knight) {
+			numDead++
+		}
+	}
+	return numDead
 }
 
 func FindKnightByName(knightName string) *Knight {
@@ -430,6 +658,10 @@ func CheckForNicknames() {
 
 		housesKillCount := make(map[*House]int)
 		for _, slayedKnight := range knight.SlayedKnights {
+			// Nobody makes songs about slaying hedge knights.
+			if slayedKnight.House == nil {
+				continue
+			}
 			if _, exist := housesKillCount[slayedKnight.House]; !exist {
 				housesKillCount[slayedKnight.House] = 0
 			}
@@ -0,0 +1,145 @@
+package game
+
+import "fmt"
+
+// GrievanceType is a kind of wrong one house can do another. The worse the
+// wrong the more it fires up the wronged house, draws other houses to its side
+// and justifies harsher terms once the war is won.
+type GrievanceType struct {
+	Name string
+
+	// Zeal is the extra morale the wronged house goes to war with.
+	Zeal int
+	// Sympathy is how much more willing other houses are to join the wronged
+	// house's war. Petty grievances put other houses off.
+	Sympathy int
+	// Claim is the harshest peace term the wronged house can demand if it wins.
+	Claim PeaceTerm
+}
+
+var BorderRaid = &GrievanceType {
+	Name:     "a border raid",
+	Zeal:     1,
+	Sympathy: 0,
+	Claim:    LandCession,
+}
+
+var BorderProvocation = &GrievanceType {
+	Name:     "a border provocation",
+	Zeal:     1,
+	Sympathy: -1,
+	Claim:    LandCession,
+}
+
+var Assassination = &GrievanceType {
+	Name:     "an assassination",
+	Zeal:     3,
+	Sympathy: 2,
+	Claim:    Vassalage,
+}
+
+var SlainNoble = &GrievanceType {
+	Name:     "a noble slain in a duel",
+	Zeal:     2,
+	Sympathy: 1,
+	Claim:    Hostages,
+}
+
+var Insult = &GrievanceType {
+	Name:     "an insult",
+	Zeal:     0,
+	Sympathy: -2,
+	Claim:    Tribute,
+}
+
+var MarriageInsult = &GrievanceType {
+	Name:     "a marriage insult",
+	Zeal:     2,
+	Sympathy: 0,
+	Claim:    Hostages,
+}
+
+var TradeDispute = &GrievanceType {
+	Name:     "a trade dispute",
+	Zeal:     0,
+	Sympathy: -1,
+	Claim:    Tribute,
+}
+
+var Blackmail = &GrievanceType {
+	Name:     "blackmail",
+	Zeal:     1,
+	Sympathy: 1,
+	Claim:    Hostages,
+}
+
+var ExecutedKnight = &GrievanceType {
+	Name:     "an executed knight",
+	Zeal:     3,
+	Sympathy: 1,
+	Claim:    Vassalage,
+}
+
+var Oathbreaking = &GrievanceType {
+	Name:     "a broken treaty",
+	Zeal:     2,
+	Sympathy: 3,
+	Claim:    Vassalage,
+}
+
+var Betrayal = &GrievanceType {
+	Name:     "a betrayal",
+	Zeal:     3,
+	Sympathy: 2,
+	Claim:    Vassalage,
+}
+
+var Oppression = &GrievanceType {
+	Name:     "an oppressive liege",
+	Zeal:     2,
+	Sympathy: 0,
+	Claim:    LandCession,
+}
+
+// Grievance is a wrong done to a house that it may one day go to war over.
+type Grievance struct {
+	Type     *GrievanceType
+	Offender *House
+	Cycle    int
+}
+
+func (grievance *Grievance) GetDescription() string {
+	return fmt.Sprintf("%s by %s in year %d", grievance.Type.Name, grievance.Offender.GetTitle(), grievance.Cycle)
+}
+
+// RecordGrievance has the victim remember a wrong done to it by the offender.
+func RecordGrievance(victim *House, offender *House, grievanceType *GrievanceType) *Grievance {
+	grievance := &Grievance{
+		Type:     grievanceType,
+		Offender: offender,
+		Cycle:    Game.Cycle,
+	}
+	if relation, exists := victim.DiplomaticRelations[offender]; exists {
+		relation.Grievances = append(relation.Grievances, grievance)
+	}
+	return grievance
+}
+
+// GetCasusBelli returns the worst grievance a house holds against another, or
+// nil if it has no cause for war.
+func GetCasusBelli(house *House, target *House) *Grievance {
+	var casusBelli *Grievance = nil
+	for _, grievance := range house.DiplomaticRelations[target].Grievances {
+		if casusBelli == nil || grievance.Type.Zeal > casusBelli.Type.Zeal {
+			casusBelli = grievance
+		}
+	}
+	return casusBelli
+}
+
+// ForgiveGrievances clears the grievances two houses hold against each other,
+// settled once and for all by a peace.
+func ForgiveGrievances(house1 *House, house2 *House) {
+	house1.DiplomaticRelations[house2].Grievances = nil
+	house2.DiplomaticRelations[house1].Grievances = nil
+}
@@ -44,8 +44,17 @@ type Knight struct {
 	SlayedKnights []*Knight
 	Nickname string
 
+	// House is nil for hedge knights, who wander the realm selling their sword.
 	House   *House
 	Sponsor *GloryBishop
+
+	// Employer is the house that has hired a hedge knight to fight in its wars.
+	Employer *House
+	Company  *MercenaryCompany
+
+	// Captor is the house holding the knight prisoner, if any.
+	Captor        *House
+	CapturedCycle int
 }
 
 func NewKnight(name string, gender Gender, prowess int, bravery int, weapon *Weapon, house *House, sponsor *GloryBishop) *Knight {
@@ -70,21 +79,38 @@ func NewKnight(name string, gender Gender, prowess int, bravery int, weapon *Wea
 
 func KillKnight(knight *Knight) {
 	if knight.Sponsor != nil {
-		titheAmount := 5 * knight.House.Wealth
-		fmt.Printf(
-			"%s paid %d coin in customary funeral tithes for %s.\n",
-			knight.House.GetTitle(), titheAmount, knight.GetTitle(),
-		)
-		knight.Sponsor.Coin += titheAmount
+		// Hedge knights are mourned by whoever was paying them, if anyone.
+		mourningHouse := knight.GetAllegiance()
+		if mourningHouse != nil {
+			titheAmount := 5 * mourningHouse.GetWealth()
+			fmt.Printf(
+				"%s paid %d coin in customary funeral tithes for %s.\n",
+				mourningHouse.GetTitle(), titheAmount, knight.GetTitle(),
+			)
+			knight.Sponsor.Coin += titheAmount
+		} else {
+			fmt.Printf("No house paid funeral tithes for %s, they died as they lived.\n", knight.GetTitle())
+		}
 	}
 
 	// Make their spouse a widow :(.
+	spouse := knight.Spouse
 	if knight.Spouse != nil {
 		fmt.Printf("%s was made a widow.\n", knight.Spouse.GetTitle())
 		knight.Spouse.Spouse = nil
 	}
 
-	knight.House.Knights = RemoveItem(knight.House.Knights, knight)
+	if knight.House != nil {
+		knight.House.Knights = RemoveItem(knight.House.Knights, knight)
+		KnightLeftHouse(knight, knight.House, spouse)
+	}
+	if knight.Captor != nil {
+		knight.Captor.Prisoners = RemoveItem(knight.Captor.Prisoners, knight)
+	}
+	if knight.Employer != nil {
+		knight.Employer.Knights = RemoveItem(knight.Employer.Knights, knight)
+	}
+	LeaveCompany(knight)
 	if knight.Sponsor != nil {
 		knight.Sponsor.SponsoredKnights = RemoveItem(knight.Sponsor.SponsoredKnights, knight)
 	}
@@ -119,8 +145,27 @@ func (knight *Knight) GetRecentReputation() float64 {
 	return recentReputation
 }
 
+// GetAllegiance returns the house the knight currently fights for. This is
+// their own house, or their employer for hedge knights. It is nil for hedge
+// knights that have not been hired.
+func (knight *Knight) GetAllegiance() *House {
+	if knight.House != nil {
+		return knight.House
+	}
+	return knight.Employer
+}
+
+func (knight *Knight) IsHedgeKnight() bool {
+	return knight.House == nil
+}
+
 func (knight *Knight) GetCost() int {
-	underlyingValue := knight.Prowess * knight.House.Might
+	// Hedge knights have no great house behind them, so the church can buy them cheaply.
+	backingMight := 1
+	if knight.House != nil {
+		backingMight = knight.House.GetMight()
+	}
+	underlyingValue := knight.Prowess * backingMight
 	// TODO: Maybe adjust the math so we can't go below 1 without need a min?
 	return 1 + int(float64(underlyingValue) * knight.GetRecentReputation())
 }
@@ -144,7 +189,16 @@ func (knight *Knight) GetTitle() string {
 		extraTitles = fmt.Sprintf(" the%s", extraTitles)
 	}
 
-	title := fmt.Sprintf("%s %s %s%s", genderedTitle, knight.Name, knight.House.Name, extraTitles)
+	var familyName string
+	if knight.House != nil {
+		familyName = knight.House.Name
+	} else if knight.Company != nil {
+		familyName = fmt.Sprintf("of the %s", knight.Company.Name)
+	} else {
+		familyName = "of the Hedge"
+	}
+
+	title := fmt.Sprintf("%s %s %s%s", genderedTitle, knight.Name, familyName, extraTitles)
 	if knight.Sponsor != nil {
 		title = ColouredText(GreenTextCode, title)
 	}
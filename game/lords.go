@@ -0,0 +1,206 @@
+package game
+
+import (
+	"fmt"
+	"sort"
+)
+
+// DisputedSuccession is the grievance of a claimant passed over for the lordship.
+var DisputedSuccession = &GrievanceType {
+	Name:     "a disputed succession",
+	Zeal:     2,
+	Sympathy: 0,
+	Claim:    Vassalage,
+}
+
+// GetClaimStrength returns how strong a knight's claim to lead their house is.
+// The realm follows those who have proven themselves.
+func GetClaimStrength(knight *Knight) int {
+	return knight.Prowess + knight.Bravery + len(knight.SlayedKnights)
+}
+
+// GetHeirs returns the knights of a house that could succeed its lord, the
+// strongest claim first. Sellswords have no claim to the house.
+func GetHeirs(house *House) []*Knight {
+	heirs := make([]*Knight, 0)
+	for _, knight := range GetSwornKnights(house) {
+		if knight.House != house || knight == house.Lord {
+			continue
+		}
+		heirs = append(heirs, knight)
+	}
+	sort.SliceStable(heirs, func(x, y int) bool {
+		return GetClaimStrength(heirs[x]) > GetClaimStrength(heirs[y])
+	})
+	return heirs
+}
+
+func (knight *Knight) IsLord() bool {
+	return knight.House != nil && knight.House.Lord == knight
+}
+
+// KnightLeftHouse settles the house's leadership after one of its knights
+// died or left. A claimant's claim leaves with them, and a lord must be succeeded.
+func KnightLeftHouse(knight *Knight, house *House, spouse *Knight) {
+	if house.Claimant == knight {
+		house.Claimant = nil
+	}
+	if house.Lord == knight {
+		SucceedLord(house, knight, spouse)
+	}
+}
+
+// SucceedLord finds a new lord for the house. The fallen lord's spouse takes
+// over if they are of the house, otherwise the knight with the strongest claim
+// does. A rival with a claim nearly as strong may dispute it.
+func SucceedLord(house *House, fallenLord *Knight, spouse *Knight) {
+	house.Lord = nil
+	house.Claimant = nil
+
+	heirs := GetHeirs(house)
+	if len(heirs) == 0 {
+		fmt.Printf("%s has no one left to succeed %s.\n", house.GetTitle(), fallenLord.GetTitle())
+		return
+	}
+	if spouse != nil && Exists(heirs, spouse) {
+		house.Lord = spouse
+		fmt.Printf("%s succeeded their spouse %s as lord of %s.\n", spouse.GetTitle(), fallenLord.GetTitle(), house.GetTitle())
+		return
+	}
+
+	heir := heirs[0]
+	house.Lord = heir
+	fmt.Printf("%s succeeded %s as lord of %s.\n", heir.GetTitle(), fallenLord.GetTitle(), house.GetTitle())
+	if len(heirs) == 1 {
+		return
+	}
+
+	rival := heirs[1]
+	heirHits := RollHits(GetClaimStrength(heir))
+	rivalHits := RollHits(GetClaimStrength(rival))
+	if rivalHits > heirHits {
+		house.Claimant = rival
+		fmt.Printf(
+			"%s disputed %s's claim to lead %s[%d vs %d]!\n",
+			rival.GetTitle(), heir.GetTitle(), house.GetTitle(), rivalHits, heirHits,
+		)
+	}
+}
+
+// ResolveSuccessionDisputes has every claimant make their move. A house with
+// land to spare splits, the claimant founding a cadet house with the knights
+// who back them. Some cadet houses swear fealty to their parent, others take
+// up arms in civil war. Claimants in houses too small to split give up.
+func ResolveSuccessionDisputes() {
+	for _, house := range CopySlice(Game.Houses) {
+		claimant := house.Claimant
+		if claimant == nil || house.Lord == nil {
+			continue
+		}
+		house.Claimant = nil
+		// A claimant in chains can't rally anyone to their cause.
+		if !Exists(house.Knights, claimant) {
+			continue
+		}
+
+		if len(GetHeldRegions(house)) < 2 {
+			fmt.Printf(
+				"%s found too little support in %s and bent the knee to %s.\n",
+				claimant.GetTitle(), house.GetTitle(), house.Lord.GetTitle(),
+			)
+			continue
+		}
+
+		followers := make([]*Knight, 0)
+		for _, knight := range GetHeirs(house) {
+			if knight != claimant && RandomRange(0, 2) == 0 {
+				followers = append(followers, knight)
+			}
+		}
+		cadetHouse := SplitHouse(house, claimant, followers)
+		fmt.Printf(
+			"%s broke away from %s with %d knight(s), founding the cadet %s. Their banner is %s.\n",
+			claimant.GetTitle(), house.GetTitle(), len(followers), cadetHouse.GetTitle(), cadetHouse.Banner.GetDescription(),
+		)
+
+		warHits := RollHits(claimant.Bravery + GetTraitModifier(house.Personality.Aggression))
+		peaceHits := RollHits(house.Lord.Bravery + GetTraitModifier(house.Personality.Honour))
+		if warHits <= peaceHits {
+			SwearFealty(cadetHouse, house)
+			continue
+		}
+
+		fmt.Printf("The dispute over %s plunged it into civil war[%d vs %d]!\n", house.GetTitle(), warHits, peaceHits)
+		cadetHouse.DiplomaticRelations[house].AddTension(
+			GrudgeThreshold, fmt.Sprintf("%s was denied the lordship of %s", claimant.GetTitle(), house.GetTitle()),
+		)
+		war := CreateWar(cadetHouse, house, RecordGrievance(cadetHouse, house, DisputedSuccession))
+		Game.Wars = append(Game.Wars, war)
+	}
+}
+
+// SplitHouse founds a new house led by a knight of the parent house. The new
+// house takes its followers from the parent along with a share of the
+// parent's land, levies and coin, and keeps its parent's character.
+func SplitHouse(parent *House, lord *Knight, followers []*Knight) *House {
+	landShareDivisor := 3
+
+	house := &House{
+		Name:        Game.FemaleNameGenerator.GenerateName(),
+		Banner:      parent.Banner,
+		Personality: parent.Personality,
+		Castle: &Castle{
+			Fortification: 1,
+		},
+		DiplomaticRelations: make(map[*House]*DiplomaticRelation, 0),
+	}
+	// The new house flies its parent's symbol in its own colours.
+	house.Banner.Color = GenerateBanner().Color
+	Game.Houses = append(Game.Houses, house)
+	InitNewDiplomaticRelations()
+
+	// Take a connected stretch of the parent's land, always leaving it at least
+	// one region to hold.
+	parentRegions := GetHeldRegions(parent)
+	keptRegion := parentRegions[0]
+	numRegions := Max(1, len(parentRegions) / landShareDivisor)
+	seat := RandomSelect(parentRegions[1:])
+	seat.Owner = house
+	claimed := []*Region{seat}
+	for len(claimed) < numRegions {
+		frontier := make([]*Region, 0)
+		for _, region := range claimed {
+			for _, neighbour := range region.Neighbours {
+				if neighbour.Owner == parent && neighbour.Occupier == nil && neighbour != keptRegion {
+					frontier = append(frontier, neighbour)
+				}
+			}
+		}
+		if len(frontier) == 0 {
+			break
+		}
+		region := RandomSelect(frontier)
+		region.Owner = house
+		claimed = append(claimed, region)
+	}
+
+	levies := parent.Army.Levies * len(claimed) / len(parentRegions)
+	parent.Army.Levies -= levies
+	house.Army = &Army{
+		Levies: levies,
+	}
+	coin := parent.Coin / landShareDivisor
+	parent.Coin -= coin
+	house.Coin = coin
+
+	for _, knight := range append([]*Knight{lord}, followers...) {
+		if knight.House == parent {
+			parent.Knights = RemoveItem(parent.Knights, knight)
+			knight.House = nil
+			KnightLeftHouse(knight, parent, nil)
+		}
+		AssignKnightToHouse(knight, house)
+	}
+	house.Lord = lord
+	return house
+}
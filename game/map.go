@@ -0,0 +1,272 @@
+package game
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Terrain struct {
+	Type     string
+	Symbol   string
+	Suffixes []string
+
+	// Might and Wealth are how much each region of this terrain adds to its owner.
+	Might  int
+	Wealth int
+	// Fronts is the number of duels fought in a battle on this terrain.
+	Fronts int
+}
+
+var Plains = &Terrain {
+	Type:     "plains",
+	Symbol:   ".",
+	Suffixes: []string{"field", "mead", "ford", "vale"},
+	Might:    1,
+	Wealth:   2,
+	Fronts:   3,
+}
+
+var Forest = &Terrain {
+	Type:     "forest",
+	Symbol:   "%",
+	Suffixes: []string{"wood", "grove", "holt"},
+	Might:    1,
+	Wealth:   1,
+	Fronts:   2,
+}
+
+var Hills = &Terrain {
+	Type:     "hills",
+	Symbol:   "n",
+	Suffixes: []string{"hill", "down", "ridge"},
+	Might:    2,
+	Wealth:   1,
+	Fronts:   2,
+}
+
+var Mountains = &Terrain {
+	Type:     "mountains",
+	Symbol:   "^",
+	Suffixes: []string{"peak", "crag", "pass"},
+	Might:    2,
+	Wealth:   0,
+	Fronts:   1,
+}
+
+var Marsh = &Terrain {
+	Type:     "marsh",
+	Symbol:   "~",
+	Suffixes: []string{"fen", "mire", "moss"},
+	Might:    0,
+	Wealth:   1,
+	Fronts:   1,
+}
+
+var AllTerrains = []*Terrain{
+	Plains, Forest, Hills, Mountains, Marsh,
+}
+
+type Region struct {
+	Name    string
+	Terrain *Terrain
+	X int
+	Y int
+
+	// Owner is nil for wild lands that no house has claimed.
+	Owner *House
+	// Occupier is an enemy house that has taken the region in a war. The owner
+	// gets nothing from the region while it is occupied.
+	Occupier   *House
+	Neighbours []*Region
+}
+
+// GetController returns the house that currently has its banners flying over the region.
+func (region *Region) GetController() *House {
+	if region.Occupier != nil {
+		return region.Occupier
+	}
+	return region.Owner
+}
+
+type WorldMap struct {
+	Width   int
+	Height  int
+	// Regions are stored row by row.
+	Regions []*Region
+}
+
+func (worldMap *WorldMap) GetRegion(x int, y int) *Region {
+	if x < 0 || x >= worldMap.Width || y < 0 || y >= worldMap.Height {
+		return nil
+	}
+	return worldMap.Regions[y * worldMap.Width + x]
+}
+
+func GenerateMap(width int, height int) *WorldMap {
+	worldMap := &WorldMap{
+		Width:   width,
+		Height:  height,
+		Regions: make([]*Region, 0, width * height),
+	}
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			terrain := RandomSelect(AllTerrains)
+			name := fmt.Sprintf("%s%s", Game.FemaleNameGenerator.GenerateName(), RandomSelect(terrain.Suffixes))
+			worldMap.Regions = append(worldMap.Regions, &Region{
+				Name:       name,
+				Terrain:    terrain,
+				X:          x,
+				Y:          y,
+				Neighbours: make([]*Region, 0, 4),
+			})
+		}
+	}
+
+	for _, region := range worldMap.Regions {
+		offsets := [][2]int{{0, -1}, {1, 0}, {0, 1}, {-1, 0}}
+		for _, offset := range offsets {
+			neighbour := worldMap.GetRegion(region.X + offset[0], region.Y + offset[1])
+			if neighbour != nil {
+				region.Neighbours = append(region.Neighbours, neighbour)
+			}
+		}
+	}
+
+	return worldMap
+}
+
+func GetHouseRegions(house *House) []*Region {
+	regions := make([]*Region, 0)
+	for _, region := range Game.Map.Regions {
+		if region.Owner == house {
+			regions = append(regions, region)
+		}
+	}
+	return regions
+}
+
+// ClaimLand gives a house a seat in the wilds and grows its lands outwards
+// from there. A house may end up with fewer regions if the wilds run out.
+func ClaimLand(house *House, numRegions int) {
+	wilds := GetHouseRegions(nil)
+	if len(wilds) == 0 {
+		return
+	}
+
+	// Prefer a seat with some wild land around it so the house can grow.
+	seat := RandomSelect(wilds)
+	for _, region := range RandomizeOrder(wilds) {
+		if len(GetWildNeighbours([]*Region{region})) >= 2 {
+			seat = region
+			break
+		}
+	}
+	seat.Owner = house
+
+	claimed := []*Region{seat}
+	for len(claimed) < numRegions {
+		frontier := GetWildNeighbours(claimed)
+		if len(frontier) == 0 {
+			break
+		}
+		region := RandomSelect(frontier)
+		region.Owner = house
+		claimed = append(claimed, region)
+	}
+}
+
+// GetWildNeighbours returns the unowned regions bordering any of the given regions.
+func GetWildNeighbours(regions []*Region) []*Region {
+	wildNeighbours := make([]*Region, 0)
+	for _, region := range regions {
+		for _, neighbour := range region.Neighbours {
+			if neighbour.Owner == nil && !Exists(wildNeighbours, neighbour) {
+				wildNeighbours = append(wildNeighbours, neighbour)
+			}
+		}
+	}
+	return wildNeighbours
+}
+
+// GetHouseDistance returns the number of regions between the lands of two
+// houses, neighbouring houses have a distance of 1. Houses without land are
+// considered to be as far away as possible.
+func GetHouseDistance(house1 *House, house2 *House) int {
+	maxDistance := Game.Map.Width + Game.Map.Height
+
+	// Breadth first search outwards from all of house1's regions at once.
+	visited := make(map[*Region]bool)
+	frontier := GetHouseRegions(house1)
+	for _, region := range frontier {
+		visited[region] = true
+	}
+
+	for distance := 0; len(frontier) > 0; distance++ {
+		nextFrontier := make([]*Region, 0)
+		for _, region := range frontier {
+			if region.Owner == house2 {
+				return distance
+			}
+			for _, neighbour := range region.Neighbours {
+				if !visited[neighbour] {
+					visited[neighbour] = true
+					nextFrontier = append(nextFrontier, neighbour)
+				}
+			}
+		}
+		frontier = nextFrontier
+	}
+	return maxDistance
+}
+
+// ReleaseLand frees the lands of a fallen house. Regions that were occupied
+// are kept by their occupiers, the rest return to the wilds.
+func ReleaseLand(house *House) {
+	for _, region := range Game.Map.Regions {
+		if region.Owner == house {
+			region.Owner = region.Occupier
+			region.Occupier = nil
+		} else if region.Occupier == house {
+			region.Occupier = nil
+		}
+	}
+}
+
+func DisplayMap() {
+	cellWidth := 4
+
+	for y := 0; y < Game.Map.Height; y++ {
+		for x := 0; x < Game.Map.Width; x++ {
+			region := Game.Map.GetRegion(x, y)
+			controller := region.GetController()
+			if controller == nil {
+				fmt.Printf(" %s", strings.Repeat(region.Terrain.Symbol, cellWidth - 1))
+			} else {
+				// Show the first letters of the house followed by the terrain. Occupied
+				// regions are shown in lower case.
+				initials := controller.Name[0:Min(len(controller.Name), cellWidth - 2)]
+				if region.Occupier != nil {
+					initials = strings.ToLower(initials)
+				}
+				cellText := fmt.Sprintf("%-*s%s", cellWidth - 2, initials, region.Terrain.Symbol)
+				fmt.Printf(" %s", ColouredText(controller.Banner.GetColourCode(), cellText))
+			}
+		}
+		fmt.Printf("\n")
+	}
+	fmt.Printf("\n")
+
+	for _, house := range Game.Houses {
+		fmt.Printf(
+			"%s holds %d regions and occupies %d under %s.\n",
+			ColouredText(house.Banner.GetColourCode(), house.GetTitle()),
+			len(GetHeldRegions(house)), len(GetOccupiedRegions(house)), house.Banner.GetDescription(),
+		)
+	}
+	fmt.Printf("Occupied regions are shown in lower case.\n")
+	for _, terrain := range AllTerrains {
+		fmt.Printf("%s %s  ", terrain.Symbol, terrain.Type)
+	}
+	fmt.Printf("\n")
+}
@@ -12,6 +12,22 @@ func MarryKnights(knight1 *Knight, knight2 *Knight) {
 		)
 		return
 	}
+	for _, knight := range []*Knight{knight1, knight2} {
+		if knight.Captor != nil {
+			fmt.Printf(
+				"%s is held prisoner by %s, they cannot be wed.\n",
+				knight.GetTitle(), knight.Captor.GetTitle(),
+			)
+			return
+		}
+	}
+	if knight1.IsHedgeKnight() && knight2.IsHedgeKnight() {
+		fmt.Printf(
+			"Neither %s nor %s has a house to offer, the church will not arrange their marriage.\n",
+			knight1.GetTitle(), knight2.GetTitle(),
+		)
+		return
+	}
 	if knight1.House == knight2.House {
 		fmt.Printf(
 			"%s and %s are from the same house, they cannot be wed.\n",
@@ -42,9 +58,14 @@ func MarryKnights(knight1 *Knight, knight2 *Knight) {
 	Game.Player.Glory -= requiredGlory
 
 	var movingKnight, stayingKnight *Knight
-	if knight1.House.Might > knight2.House.Might {
+	if knight1.IsHedgeKnight() {
+		// Hedge knights always marry into the house, gaining a name.
+		stayingKnight, movingKnight = knight2, knight1
+	} else if knight2.IsHedgeKnight() {
+		stayingKnight, movingKnight = knight1, knight2
+	} else if knight1.House.GetMight() > knight2.House.GetMight() {
 		stayingKnight, movingKnight = knight1, knight2
-	} else if knight2.House.Might > knight1.House.Might {
+	} else if knight2.House.GetMight() > knight1.House.GetMight() {
 		stayingKnight, movingKnight = knight2, knight1
 	} else {
 		// If might matches the user can decide based on the order they give.
@@ -56,6 +77,17 @@ func MarryKnights(knight1 *Knight, knight2 *Knight) {
 		movingKnight.GetTitle(), stayingKnight.GetTitle(), movingKnight.GetTitle(), stayingKnight.House.GetTitle(),
 	)
 
+	if movingKnight.IsHedgeKnight() {
+		// A hedge knight has no house to make peace with, they just give up the road.
+		ReleaseKnight(movingKnight)
+		LeaveCompany(movingKnight)
+		AssignKnightToHouse(movingKnight, stayingKnight.House)
+
+		movingKnight.Spouse = stayingKnight
+		stayingKnight.Spouse = movingKnight
+		return
+	}
+
 	tensionReducedAmount := 5
 	fmt.Printf(
 		"Tensions between %s and %s are reduced by %d.\n",
@@ -65,12 +97,15 @@ func MarryKnights(knight1 *Knight, knight2 *Knight) {
 	// the moving knight.
 	relation1 := movingKnight.House.DiplomaticRelations[stayingKnight.House]
 	relation2 := stayingKnight.House.DiplomaticRelations[movingKnight.House]
-	relation1.Tension = Max(relation1.Tension - tensionReducedAmount, 0)
-	relation2.Tension = Max(relation2.Tension - tensionReducedAmount, 0)
+	marriageText := fmt.Sprintf("%s married %s", movingKnight.GetTitle(), stayingKnight.GetTitle())
+	relation1.ReduceTension(tensionReducedAmount, marriageText)
+	relation2.ReduceTension(tensionReducedAmount, marriageText)
 
-	movingKnight.House.Knights = RemoveItem(movingKnight.House.Knights, movingKnight)
+	oldHouse := movingKnight.House
+	oldHouse.Knights = RemoveItem(oldHouse.Knights, movingKnight)
 	stayingKnight.House.Knights = append(stayingKnight.House.Knights, movingKnight)
 	movingKnight.House = stayingKnight.House
+	KnightLeftHouse(movingKnight, oldHouse, nil)
 
 	movingKnight.Spouse = stayingKnight
 	stayingKnight.Spouse = movingKnight
@@ -0,0 +1,249 @@
+package game
+
+import (
+	"fmt"
+)
+
+// MercenaryCompany is a band of hedge knights that sells its swords as one.
+type MercenaryCompany struct {
+	Name    string
+	Knights []*Knight
+
+	Employer *House
+}
+
+// GetFee returns the coin a house must pay to hire the whole company.
+func (company *MercenaryCompany) GetFee() int {
+	fee := 0
+	for _, knight := range company.Knights {
+		// Captive knights aren't hired along with the company.
+		if knight.Captor != nil {
+			continue
+		}
+		fee += GetHireFee(knight)
+	}
+	return fee
+}
+
+// GetHireFee returns the coin a house must pay to hire a hedge knight for a war.
+func GetHireFee(knight *Knight) int {
+	return 2 * knight.Prowess
+}
+
+// LeaveCompany takes a knight out of their company, disbanding it if they were
+// the last one left.
+func LeaveCompany(knight *Knight) {
+	company := knight.Company
+	if company == nil {
+		return
+	}
+	company.Knights = RemoveItem(company.Knights, knight)
+	knight.Company = nil
+	if len(company.Knights) == 0 {
+		fmt.Printf("With no swords left to sell, the %s disbanded.\n", company.Name)
+		ReleaseCompany(company)
+		Game.MercenaryCompanies = RemoveItem(Game.MercenaryCompanies, company)
+	}
+}
+
+func GenerateMercenaryCompany() *MercenaryCompany {
+	// TODO: Move these to input files or something.
+	adjectives := []string{
+		"Golden", "Iron", "Bloody", "Broken", "Laughing", "Second", "Ragged", "Grey", "Wandering", "Black",
+	}
+	nouns := []string{
+		"Company", "Band", "Swords", "Lances", "Brotherhood", "Host",
+	}
+
+	company := &MercenaryCompany{
+		Name:    fmt.Sprintf("%s %s", RandomSelect(adjectives), RandomSelect(nouns)),
+		Knights: make([]*Knight, 0),
+	}
+
+	numKnights := RandomRange(2, 5)
+	for idx := 0; idx < numKnights; idx++ {
+		knight := GenerateKnight(nil)
+		knight.Company = company
+		company.Knights = append(company.Knights, knight)
+	}
+
+	Game.MercenaryCompanies = append(Game.MercenaryCompanies, company)
+	return company
+}
+
+// GetWanderingHedgeKnights returns hedge knights that are not in a company and
+// are not currently hired.
+func GetWanderingHedgeKnights() []*Knight {
+	wanderers := make([]*Knight, 0)
+	for _, knight := range Game.Knights {
+		if knight.IsHedgeKnight() && knight.Company == nil && knight.Employer == nil && knight.Captor == nil {
+			wanderers = append(wanderers, knight)
+		}
+	}
+	return wanderers
+}
+
+// GetSwornKnights returns the house's own knights, leaving out the sellswords
+// fighting alongside them. Sellswords are paid up front when they're hired and
+// owe the house nothing more.
+func GetSwornKnights(house *House) []*Knight {
+	swornKnights := make([]*Knight, 0, len(house.Knights))
+	for _, knight := range house.Knights {
+		if knight.Employer == nil {
+			swornKnights = append(swornKnights, knight)
+		}
+	}
+	return swornKnights
+}
+
+func HireKnight(house *House, knight *Knight) {
+	knight.Employer = house
+	house.Knights = append(house.Knights, knight)
+}
+
+func ReleaseKnight(knight *Knight) {
+	if knight.Employer == nil {
+		return
+	}
+	knight.Employer.Knights = RemoveItem(knight.Employer.Knights, knight)
+	knight.Employer = nil
+}
+
+func HireCompany(house *House, company *MercenaryCompany) {
+	company.Employer = house
+	for _, knight := range company.Knights {
+		// Captive knights can't fight for anyone.
+		if knight.Captor != nil {
+			continue
+		}
+		HireKnight(house, knight)
+	}
+}
+
+func ReleaseCompany(company *MercenaryCompany) {
+	for _, knight := range company.Knights {
+		ReleaseKnight(knight)
+	}
+	company.Employer = nil
+}
+
+// ReleaseAllSellswords ends the contract of every hedge knight and company
+// working for the house.
+func ReleaseAllSellswords(house *House) {
+	for _, company := range Game.MercenaryCompanies {
+		if company.Employer == house {
+			ReleaseCompany(company)
+		}
+	}
+	for _, knight := range CopySlice(house.Knights) {
+		if knight.Employer == house {
+			ReleaseKnight(knight)
+		}
+	}
+}
+
+// HireSellswords lets every house at war spend its coin on hedge knights and
+// mercenary companies. Each house signs at most one contract per year.
+func HireSellswords() {
+	for _, house := range RandomizeOrder(Game.Houses) {
+		if house.NumWars() == 0 {
+			continue
+		}
+
+		// Houses prefer the strength of a whole company if they can afford it.
+		hired := false
+		for _, company := range RandomizeOrder(Game.MercenaryCompanies) {
+			if company.Employer != nil || len(company.Knights) == 0 {
+				continue
+			}
+			fee := company.GetFee()
+			if fee > house.Coin {
+				continue
+			}
+			house.Coin -= fee
+			HireCompany(house, company)
+			fmt.Printf("%s hired the %s for %d coin.\n", house.GetTitle(), company.Name, fee)
+			hired = true
+			break
+		}
+		if hired {
+			continue
+		}
+
+		for _, knight := range RandomizeOrder(GetWanderingHedgeKnights()) {
+			fee := GetHireFee(knight)
+			if fee > house.Coin {
+				continue
+			}
+			house.Coin -= fee
+			HireKnight(house, knight)
+			fmt.Printf("%s hired %s for %d coin.\n", house.GetTitle(), knight.GetTitle(), fee)
+			break
+		}
+	}
+}
+
+// ReleaseSellswords sends hedge knights and companies back to wandering once
+// their employer is no longer at war.
+func ReleaseSellswords() {
+	for _, house := range Game.Houses {
+		if house.NumWars() == 0 {
+			ReleaseAllSellswords(house)
+		}
+	}
+}
+
+// MercenariesSwitchSides gives the enemies of a company's employer the chance
+// to buy the company's loyalty once a war has dragged on.
+func MercenariesSwitchSides() {
+	minWarLength := 3
+
+	for _, company := range Game.MercenaryCompanies {
+		if company.Employer == nil {
+			continue
+		}
+
+		for _, war := range Game.Wars {
+			if war.GetLength() < minWarLength {
+				continue
+			}
+
+			var enemies *Alliance
+			if HouseIsInAlliance(war.Attackers, company.Employer) {
+				enemies = war.Defenders
+			} else if HouseIsInAlliance(war.Defenders, company.Employer) {
+				enemies = war.Attackers
+			} else {
+				continue
+			}
+
+			// The richest enemy makes an offer that beats the company's current pay.
+			bidder := enemies.Leader
+			for _, ally := range enemies.Allies {
+				if ally.Coin > bidder.Coin {
+					bidder = ally
+				}
+			}
+
+			offer := company.GetFee() + 2
+			if bidder.Coin < offer || bidder.Coin <= company.Employer.Coin {
+				continue
+			}
+			bidderHits := RollHits(bidder.GetWealth())
+			employerHits := RollHits(company.Employer.GetWealth())
+			if bidderHits <= employerHits {
+				continue
+			}
+
+			fmt.Printf(
+				"The %s turned their cloaks! %s paid them %d coin to abandon %s[%d/%dd vs %d/%dd].\n",
+				company.Name, bidder.GetTitle(), offer, company.Employer.GetTitle(),
+				bidderHits, bidder.GetWealth(), employerHits, company.Employer.GetWealth(),
+			)
+			bidder.Coin -= offer
+			ReleaseCompany(company)
+			HireCompany(bidder, company)
+			break
+		}
+	}
+}
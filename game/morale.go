@@ -0,0 +1,171 @@
+package game
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// MoraleChange records a change to an alliance's morale so the course of a
+// war can be shown to the player.
+type MoraleChange struct {
+	Cycle    int
+	Season   Season
+	Alliance *Alliance
+	Amount   int
+	Reason   string
+	Morale   int
+}
+
+// BattleReport summarises the outcome of a battle for the wars it is fought in.
+type BattleReport struct {
+	// AttackerMargin will be <=0 if the attackers lost and >0 if they won.
+	AttackerMargin int
+
+	AttackerDuelWins int
+	DefenderDuelWins int
+	AttackerDeaths   int
+	DefenderDeaths   int
+}
+
+// GetMedianBravery returns the median bravery of all knights in the houses.
+func GetMedianBravery(houses []*House) int {
+	braveries := make([]int, 0)
+	for _, knight := range GetCoalitionKnights(houses) {
+		braveries = append(braveries, knight.Bravery)
+	}
+	if len(braveries) == 0 {
+		return 1
+	}
+	sort.Ints(braveries)
+	return braveries[len(braveries) / 2]
+}
+
+// GetStartingMorale sets an alliance's morale from the bravery of its knights.
+// Built up tension and the grievance that caused the war stir up extra zeal.
+func GetStartingMorale(alliance *Alliance, grievanceTension int, grievanceZeal int) int {
+	baseMorale := 3
+	maxZeal := 3
+	return baseMorale + GetMedianBravery(alliance.GetHouses()) + Min(maxZeal, grievanceTension / 3) + grievanceZeal
+}
+
+func (war *War) ChangeMorale(alliance *Alliance, amount int, reason string) {
+	if amount == 0 {
+		return
+	}
+	alliance.Morale += amount
+	war.MoraleHistory = append(war.MoraleHistory, &MoraleChange{
+		Cycle:    Game.Cycle,
+		Season:   Game.Season,
+		Alliance: alliance,
+		Amount:   amount,
+		Reason:   reason,
+		Morale:   alliance.Morale,
+	})
+
+	direction := "rose"
+	if amount < 0 {
+		direction = "dropped"
+	}
+	fmt.Printf(
+		"The morale of %s's alliance %s to %d(%s)\n",
+		alliance.Leader.GetTitle(), direction, alliance.Morale, reason,
+	)
+}
+
+// ApplyBattleMorale changes the morale of both sides of a battle. Losing the
+// battle, losing champions and losing knights all hurt, winning duels helps.
+func (war *War) ApplyBattleMorale(attackingSide *Alliance, defendingSide *Alliance, report *BattleReport) {
+	attackerMargin := Max(0, report.AttackerMargin)
+	defenderMargin := Max(0, -report.AttackerMargin)
+
+	war.applySideBattleMorale(
+		attackingSide, defenderMargin, report.AttackerDuelWins, report.DefenderDuelWins, report.AttackerDeaths,
+	)
+	war.applySideBattleMorale(
+		defendingSide, attackerMargin, report.DefenderDuelWins, report.AttackerDuelWins, report.DefenderDeaths,
+	)
+}
+
+func (war *War) applySideBattleMorale(alliance *Alliance, lossMargin int, duelWins int, duelLosses int, deaths int) {
+	amount := 0
+	reasons := make([]string, 0)
+	if lossMargin > 0 {
+		amount -= lossMargin
+		reasons = append(reasons, fmt.Sprintf("lost a battle by %d", lossMargin))
+	}
+	if duelWins > 0 {
+		amount += duelWins
+		reasons = append(reasons, fmt.Sprintf("won %d duel(s)", duelWins))
+	}
+	if duelLosses > 0 {
+		amount -= duelLosses
+		reasons = append(reasons, fmt.Sprintf("lost %d champion(s)", duelLosses))
+	}
+	if deaths > 0 {
+		// Every second knight lost weighs on the alliance, a single death is shrugged off.
+		amount -= deaths / 2
+		reasons = append(reasons, fmt.Sprintf("%d knight(s) fell", deaths))
+	}
+	war.ChangeMorale(alliance, amount, strings.Join(reasons, ", "))
+}
+
+// DoDesertions gives the knights and allies of a demoralised alliance the
+// chance to abandon the war before each campaign.
+func (war *War) DoDesertions() {
+	desertionMorale := 2
+
+	for _, alliance := range []*Alliance{war.Attackers, war.Defenders} {
+		if alliance.Morale > desertionMorale {
+			continue
+		}
+
+		for _, knight := range GetCoalitionKnights(alliance.GetHouses()) {
+			// Only knights that fail to muster any bravery run.
+			if RollHits(knight.Bravery) > 0 {
+				continue
+			}
+			knightTitle := knight.GetTitle()
+			fmt.Printf("%s deserted %s and took to the road.\n", knightTitle, knight.GetAllegiance().GetTitle())
+			DesertKnight(knight)
+			war.ChangeMorale(alliance, -1, fmt.Sprintf("%s deserted", knightTitle))
+		}
+
+		for _, ally := range CopySlice(alliance.Allies) {
+			if IsBoundToAlliance(ally, alliance) {
+				continue
+			}
+			tensionWithEnemy := ally.DiplomaticRelations[war.GetEnemyAlliance(alliance).Leader].GetTension()
+			if RollHits(tensionWithEnemy) > 0 {
+				continue
+			}
+			fmt.Printf("%s abandoned %s's alliance.\n", ally.GetTitle(), alliance.Leader.GetTitle())
+			war.LeaveAlliance(ally, alliance)
+			war.ChangeMorale(alliance, -2, fmt.Sprintf("%s deserted the alliance", ally.GetTitle()))
+		}
+	}
+}
+
+// DesertKnight strips a knight of their house and turns them into a hedge knight.
+func DesertKnight(knight *Knight) {
+	ReleaseKnight(knight)
+	if knight.House != nil {
+		house := knight.House
+		house.Knights = RemoveItem(house.Knights, knight)
+		knight.House = nil
+		KnightLeftHouse(knight, house, nil)
+	}
+}
+
+// BlessingRaisesMorale lifts the spirits of every alliance the blessed knight fights for.
+func BlessingRaisesMorale(knight *Knight) {
+	allegiance := knight.GetAllegiance()
+	if allegiance == nil {
+		return
+	}
+	for _, war := range Game.Wars {
+		if alliance := war.GetAlliance(allegiance); alliance != nil {
+			war.ChangeMorale(alliance, 1, fmt.Sprintf("%s was blessed", knight.GetTitle()))
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package game
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MaxTrait is the highest a personality trait can be.
+var MaxTrait = 4
+
+// AverageTrait is the trait of a house that is neither one way nor the other.
+// Traits above it push a house's decisions one way, traits below the other.
+var AverageTrait = 2
+
+// Personality is the character of a house, passed down from lord to lord.
+type Personality struct {
+	// Aggression is how quick the house is to antagonise others and go to war.
+	Aggression int
+	// Honour is how much the house cares for its word and a just cause.
+	Honour int
+	// Piety is how much the house heeds the church.
+	Piety int
+	// Greed is how much the house wants out of a peace.
+	Greed int
+}
+
+// GetDescription describes the traits the house is known for.
+func (personality *Personality) GetDescription() string {
+	traits := make([]string, 0)
+	describeTrait := func(trait int, highText string, lowText string) {
+		if trait > AverageTrait {
+			traits = append(traits, highText)
+		} else if trait < AverageTrait {
+			traits = append(traits, lowText)
+		}
+	}
+	describeTrait(personality.Aggression, "aggressive", "peaceful")
+	describeTrait(personality.Honour, "honourable", "treacherous")
+	describeTrait(personality.Piety, "pious", "godless")
+	describeTrait(personality.Greed, "greedy", "generous")
+
+	if len(traits) == 0 {
+		return "unremarkable"
+	}
+	return strings.Join(traits, ", ")
+}
+
+func (personality *Personality) GetStats() string {
+	return fmt.Sprintf(
+		"aggression: %d, honour: %d, piety: %d, greed: %d",
+		personality.Aggression, personality.Honour, personality.Piety, personality.Greed,
+	)
+}
+
+func GeneratePersonality() Personality {
+	return Personality{
+		Aggression: RandomRange(0, MaxTrait + 1),
+		Honour:     RandomRange(0, MaxTrait + 1),
+		Piety:      RandomRange(0, MaxTrait + 1),
+		Greed:      RandomRange(0, MaxTrait + 1),
+	}
+}
+
+// GetTraitModifier returns how far a trait pushes a decision away from what an
+// average house would do.
+func GetTraitModifier(trait int) int {
+	return trait - AverageTrait
+}
+
+// SelectAntagonist picks a house to stir up trouble. Aggressive houses are
+// far more likely to be the ones picking fights.
+func SelectAntagonist(houses []*House) *House {
+	totalWeight := 0
+	for _, house := range houses {
+		totalWeight += 1 + house.Personality.Aggression
+	}
+
+	roll := RandomRange(0, totalWeight)
+	for _, house := range houses {
+		roll -= 1 + house.Personality.Aggression
+		if roll < 0 {
+			return house
+		}
+	}
+	return houses[len(houses) - 1]
+}
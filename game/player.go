@@ -31,6 +31,29 @@ func ResearchKnight(knight *Knight) {
 
 	fmt.Printf("%s fights with a %s\n", knight.GetTitle(), knight.Weapon.Type)
 
+	if knight.IsHedgeKnight() {
+		if knight.Employer != nil {
+			fmt.Printf("%s is a hedge knight in the pay of %s.\n", knight.GetTitle(), knight.Employer.GetTitle())
+		} else {
+			fmt.Printf("%s is a hedge knight looking for work, they can be hired for %d coin.\n", knight.GetTitle(), GetHireFee(knight))
+		}
+	} else if knight.IsLord() {
+		fmt.Printf("%s is the lord of %s.\n", knight.GetTitle(), knight.House.GetTitle())
+	} else if knight.House.Claimant == knight {
+		fmt.Printf("%s disputes %s's right to lead %s.\n", knight.GetTitle(), knight.House.Lord.GetTitle(), knight.House.GetTitle())
+	}
+
+	if knight.Captor != nil && IsHostage(knight) {
+		fmt.Printf("%s is being held hostage by %s to guarantee the peace.\n", knight.GetTitle(), knight.Captor.GetTitle())
+	} else if knight.Captor != nil {
+		fmt.Printf(
+			"%s has been held prisoner by %s since year %d, their ransom is %d coin.\n",
+			knight.GetTitle(), knight.Captor.GetTitle(), knight.CapturedCycle, GetRansom(knight),
+		)
+	} else {
+		fmt.Printf("%s is free.\n", knight.GetTitle())
+	}
+
 	if knight.Spouse == nil {
 		fmt.Printf("%s is unmarried.\n", knight.GetTitle())
 	} else {
@@ -56,18 +79,101 @@ func ResearchKnight(knight *Knight) {
 }
 
 func ResearchHouse(house *House) {
+	if house.Lord != nil {
+		fmt.Printf("%s is led by %s[claim: %d].\n", house.GetTitle(), house.Lord.GetTitle(), GetClaimStrength(house.Lord))
+	} else {
+		fmt.Printf("%s has no lord.\n", house.GetTitle())
+	}
+	if house.Claimant != nil {
+		fmt.Printf("%s disputes the lordship[claim: %d].\n", house.Claimant.GetTitle(), GetClaimStrength(house.Claimant))
+	}
+	if house.Infamy > 0 {
+		fmt.Printf("%s is infamous for preying on weaker houses[infamy: %d].\n", house.GetTitle(), house.Infamy)
+	}
+	if house.Liege != nil {
+		fmt.Printf("%s has sworn fealty to %s.\n", house.GetTitle(), house.Liege.GetTitle())
+	}
+	for _, agreement := range GetTradeAgreements(house) {
+		fmt.Printf(
+			"%s has traded with %s since year %d, earning %d coin a year.\n",
+			house.GetTitle(), agreement.GetPartner(house).GetTitle(), agreement.StartCycle, TradeAgreementIncome,
+		)
+	}
+	for _, treaty := range Game.Treaties {
+		if treaty.Victor == house {
+			fmt.Printf(
+				"%s has a treaty with %s until year %d, they agreed to %s.\n",
+				house.GetTitle(), treaty.Vanquished.GetTitle(), treaty.GetEndCycle(), GetTermsText(treaty.Terms),
+			)
+		} else if treaty.Vanquished == house {
+			fmt.Printf(
+				"%s has a treaty with %s until year %d, %s agreed to %s.\n",
+				house.GetTitle(), treaty.Victor.GetTitle(), treaty.GetEndCycle(), house.GetTitle(), GetTermsText(treaty.Terms),
+			)
+		}
+	}
+	for _, prisoner := range house.Prisoners {
+		fmt.Printf("%s holds %s prisoner for a ransom of %d coin.\n", house.GetTitle(), prisoner.GetTitle(), GetRansom(prisoner))
+	}
 	for targetHouse, relation := range house.DiplomaticRelations {
 		fmt.Printf(
-			"%s's tensions with %s are at %d\n",
-			house.GetTitle(), targetHouse.GetTitle(), relation.Tension,
+			"%s's tensions with %s are at %d[grudge: %d, irritation: %d]\n",
+			house.GetTitle(), targetHouse.GetTitle(), relation.GetTension(), relation.Grudge, relation.Irritation,
 		)
+		DisplayIncidents(relation)
+		if relation.LastWarDecision != nil {
+			fmt.Printf("  In year %d %s\n", relation.LastWarDecision.Cycle, relation.LastWarDecision.GetExplanation())
+		}
+		for _, grievance := range relation.Grievances {
+			fmt.Printf("  %s holds a grievance over %s.\n", house.GetTitle(), grievance.GetDescription())
+		}
 	}
 }
 
 func DisplayHouses() {
+	DisplayHierarchy()
+
 	for _, house := range Game.Houses {
-		fmt.Printf("Introducing the knights of %s[might: %d, wealth: %d]! Their banner is %s.\n", house.GetTitle(), house.Might, house.Wealth, house.Banner.GetDescription())
+		fmt.Printf(
+			"Introducing the knights of %s[might: %d, levies: %d/%d, wealth: %d, coin: %d, income: %d, upkeep: %d, regions: %d, walls: %d]! Their banner is %s.\n",
+			house.GetTitle(), house.GetMight(), house.Army.Levies, GetLevyCapacity(house),
+			house.GetWealth(), house.Coin, GetIncome(house), GetUpkeep(house),
+			len(GetHeldRegions(house)), house.Castle.Fortification,
+			house.Banner.GetDescription(),
+		)
+		fmt.Printf("%s is known to be %s[%s].\n", house.GetTitle(), house.Personality.GetDescription(), house.Personality.GetStats())
+		if house.Lord != nil {
+			fmt.Printf("%s is the lord of %s.\n", house.Lord.GetTitle(), house.GetTitle())
+		}
 		for _, knight := range house.Knights {
+			sellswordText := ""
+			if knight.Employer == house {
+				sellswordText = ", sellsword"
+			}
+			fmt.Printf(
+				"%s! [prowess: %d, bravery: %d, cost: %d%s]\n",
+				knight.GetTitle(), knight.Prowess, knight.Bravery, knight.GetCost(), sellswordText,
+			)
+		}
+		fmt.Printf("\n")
+	}
+
+	fmt.Printf("Hedge knights wandering the realm:\n")
+	for _, knight := range GetWanderingHedgeKnights() {
+		fmt.Printf(
+			"%s! [prowess: %d, bravery: %d, cost: %d, hire fee: %d]\n",
+			knight.GetTitle(), knight.Prowess, knight.Bravery, knight.GetCost(), GetHireFee(knight),
+		)
+	}
+	fmt.Printf("\n")
+
+	for _, company := range Game.MercenaryCompanies {
+		if company.Employer != nil {
+			fmt.Printf("The %s fights for %s.\n", company.Name, company.Employer.GetTitle())
+		} else {
+			fmt.Printf("The %s is looking for an employer[hire fee: %d].\n", company.Name, company.GetFee())
+		}
+		for _, knight := range company.Knights {
 			fmt.Printf(
 				"%s! [prowess: %d, bravery: %d, cost: %d]\n",
 				knight.GetTitle(), knight.Prowess, knight.Bravery, knight.GetCost(),
@@ -79,15 +185,18 @@ func DisplayHouses() {
 
 func DisplayWars() {
 	for _, war := range Game.Wars {
+		attacker := war.Attackers.Leader
+		defender := war.Defenders.Leader
+		if war.CasusBelli != nil {
+			fmt.Printf("The war was declared in year %d over %s.\n", war.StartCycle, war.CasusBelli.GetDescription())
+		}
+
 		w := tabwriter.NewWriter(os.Stdout, 1, 1, 1, ' ', 0)
 		fmt.Fprintf(
 			w, "Turn\tAttackers[morale: %d]\tDefenders[morale: %d]\n",
 			war.Attackers.Morale, war.Defenders.Morale,
 		)
 
-		attacker := war.Attackers.Leader
-		defender := war.Defenders.Leader
-
 		turnIcon := ""
 		if war.attackingHouseIdx == 0 {
 			turnIcon = "*"
@@ -96,8 +205,8 @@ func DisplayWars() {
 		fmt.Fprintf(
 			w,"%s\t%s[might: %d]\t%s[might: %d]\n",
 			turnIcon,
-			attacker.GetTitle(), attacker.Might,
-			defender.GetTitle(), defender.Might,
+			attacker.GetTitle(), attacker.GetMight(),
+			defender.GetTitle(), defender.GetMight(),
 		)
 
 		for allyIdx := 0; allyIdx < Max[int](len(war.Attackers.Allies), len(war.Defenders.Allies)); allyIdx++ {
@@ -111,7 +220,7 @@ func DisplayWars() {
 				ally := war.Attackers.Allies[allyIdx]
 				fmt.Fprintf(
 					w, "%s[might: %d]\t",
-					ally.GetTitle(), ally.Might,
+					ally.GetTitle(), ally.GetMight(),
 				)
 			} else {
 				fmt.Fprintf(w, "\t")
@@ -121,15 +230,48 @@ func DisplayWars() {
 				ally := war.Defenders.Allies[allyIdx]
 				fmt.Fprintf(
 					w, "%s[might: %d]\n",
-					ally.GetTitle(), ally.Might,
+					ally.GetTitle(), ally.GetMight(),
 				)
 			} else {
 				fmt.Fprintf(w, "\n")
 			}
 		}
 		w.Flush()
+
+		// Only show the most recent changes so long wars don't flood the screen.
+		maxHistoryLength := 8
+		historyStartIdx := Max(0, len(war.MoraleHistory) - maxHistoryLength)
+		for _, change := range war.MoraleHistory[historyStartIdx:] {
+			fmt.Printf(
+				"%s: %s's alliance %+d morale to %d(%s)\n",
+				FormatDate(change.Cycle, change.Season), change.Alliance.Leader.GetTitle(), change.Amount, change.Morale, change.Reason,
+			)
+		}
+
+		for _, change := range war.LeaderChanges {
+			fmt.Printf(
+				"%s: %s took over leadership from the fallen %s.\n",
+				FormatDate(change.Cycle, change.Season), change.Successor.GetTitle(), change.Fallen.GetTitle(),
+			)
+		}
+
+		for _, siege := range war.Sieges {
+			fmt.Printf(
+				"%s is besieging %s[walls: %d, supplies: %d, siege morale: %d, campaigns: %d]\n",
+				siege.Besieger.GetTitle(), siege.Defender.GetTitle(),
+				siege.Defender.Castle.Fortification, siege.Supplies, siege.Morale, siege.Length,
+			)
+		}
 		fmt.Printf("\n")
 	}
+
+	for _, treaty := range Game.Treaties {
+		fmt.Printf(
+			"%s and %s are at peace until year %d, %s agreed to %s.\n",
+			treaty.Victor.GetTitle(), treaty.Vanquished.GetTitle(), treaty.GetEndCycle(),
+			treaty.Vanquished.GetTitle(), GetTermsText(treaty.Terms),
+		)
+	}
 }
 
 func DisplayDiplomacy() {
@@ -157,9 +299,15 @@ func DisplayDiplomacy() {
 				fmt.Fprintf(w, "%s\t", ColouredText(DefaultColourCode ,"X"))
 			} else {
 				// TODO: Colour numbers on severity?
-				tension := sourceHouse.DiplomaticRelations[targetHouse].Tension
+				tension := sourceHouse.DiplomaticRelations[targetHouse].GetTension()
 				tensionColour := tensionSeverity[Min[int](2, tension / 3)]
-				tensionText := ColouredText(tensionColour, strconv.Itoa(tension))
+				vassalMark := ""
+				if targetHouse.Liege == sourceHouse {
+					vassalMark = "(V)"
+				} else if sourceHouse.Liege == targetHouse {
+					vassalMark = "(L)"
+				}
+				tensionText := ColouredText(tensionColour, strconv.Itoa(tension) + vassalMark)
 				fmt.Fprintf(w, "%s\t", tensionText)
 			}
 		}
@@ -167,10 +315,11 @@ func DisplayDiplomacy() {
 	}
 
 	w.Flush()
+	fmt.Printf("(V) marks a house's vassal, (L) marks its liege.\n")
 }
 
 func DoPlayerTurn() {
-	fmt.Printf("Year %d - You have %d coin and %d glory.\n", Game.Cycle, Game.Player.Coin, Game.Player.Glory)
+	fmt.Printf("%s - You have %d coin and %d glory.\n", GetDateText(), Game.Player.Coin, Game.Player.Glory)
 
 	// Player interaction loop.
 	// TODO: For the love of god clean this up.
@@ -189,10 +338,17 @@ func DoPlayerTurn() {
 				"sponsor <knight-name>: pay a knight's cost in coin to sponsor them, gaining glory from their victories and coin when they die.\n" +
 					"marry <knight-name> <knight-name>: marry two knights, moving a knight from the weaker house into the stronger house. This reduces tension between the houses.\n" +
 					"bless <knight-name>: Pay glory to give the knight +1d to their prowess in combat. Blessings can stack for an increased cost.\n" +
+					"tourney <prize>: host a tournament, paying the prize and " + strconv.Itoa(ChurchTournamentCost) + " coin. Sponsored knights that win jousts earn glory.\n" +
+					"ransom <knight-name>: pay the ransom of a captive knight you sponsor.\n" +
+					"trade <house-name> <house-name> <coin|glory>: broker a trade agreement between two houses for " + strconv.Itoa(TradeBrokerCoinCost) + " coin or " + strconv.Itoa(TradeBrokerGloryCost) + " glory. Trading houses grow richer and their tensions ease.\n" +
+					"mediate <house-name>: pay " + strconv.Itoa(MediationCost) + " coin to push a war the house leads towards peace. Brokering peace earns glory.\n" +
+					"charity <house-name>: pay " + strconv.Itoa(CharityCost) + " coin to ease a calamity suffered by the house, earning " + strconv.Itoa(CharityGlory) + " glory and making the house more pious.\n" +
 					"research <knight-name|house-name>: discover information about a knight or house.\n" +
-					"houses: display information about all houses.\n" +
+					"map: display the lands of each house.\n" +
+					"houses: display the lieges and vassals of the realm and information about all houses, hedge knights and mercenary companies.\n" +
 					"wars: display information about all in progress wars.\n" +
 					"tensions: show the tensions between each of the houses.\n" +
+					"events: show the calamities afflicting the realm.\n" +
 					"done: finalise your sponsorships for this season\n",
 			)
 		} else if command[0] == "research" {
@@ -232,6 +388,97 @@ func DoPlayerTurn() {
 				"You have sponsored %s, %d coin remaining\n",
 				foundKnight.GetTitle(), Game.Player.Coin,
 			)
+		} else if command[0] == "tourney" {
+			if len(command) < 2 {
+				fmt.Printf("Specify the prize(tourney <prize>)\n")
+				continue
+			}
+			prize, err := strconv.Atoi(command[1])
+			if err != nil || prize < 0 {
+				fmt.Printf("'%s' is not a valid prize\n", command[1])
+				continue
+			}
+
+			cost := prize + ChurchTournamentCost
+			if cost > Game.Player.Coin {
+				fmt.Printf("Hosting a tournament with that prize costs %d coin, you only have %d.\n", cost, Game.Player.Coin)
+				continue
+			}
+			Game.Player.Coin -= cost
+			HostTournament(nil, prize)
+			fmt.Printf("You will host a tournament in the spring with a prize of %d coin, %d coin remaining\n", prize, Game.Player.Coin)
+		} else if command[0] == "ransom" {
+			if len(command) < 2 {
+				fmt.Printf("Specify a knight(ransom <first-name>)\n")
+				continue
+			}
+			knight := FindKnightByName(command[1])
+			if knight == nil {
+				fmt.Printf("Could not find knight '%s'\n", command[1])
+				continue
+			}
+			if knight.Captor == nil {
+				fmt.Printf("%s is not being held prisoner\n", knight.GetTitle())
+				continue
+			}
+			if IsHostage(knight) {
+				fmt.Printf("%s is a hostage, they will only be released when the treaty ends\n", knight.GetTitle())
+				continue
+			}
+			if knight.Sponsor != Game.Player {
+				fmt.Printf("The church only pays the ransoms of knights it sponsors\n")
+				continue
+			}
+
+			ransom := GetRansom(knight)
+			if ransom > Game.Player.Coin {
+				fmt.Printf("%s's ransom is %d coin, you only have %d.\n", knight.GetTitle(), ransom, Game.Player.Coin)
+				continue
+			}
+			Game.Player.Coin -= ransom
+			PayRansom(knight, "The Church")
+		} else if command[0] == "trade" {
+			if len(command) < 4 {
+				fmt.Printf("Specify two houses and how to pay(trade <house-name> <house-name> <coin|glory>)\n")
+				continue
+			}
+			house1 := FindHouseByName(command[1])
+			if house1 == nil {
+				fmt.Printf("Could not find house '%s'\n", command[1])
+				continue
+			}
+			house2 := FindHouseByName(command[2])
+			if house2 == nil {
+				fmt.Printf("Could not find house '%s'\n", command[2])
+				continue
+			}
+			BrokerTradeAgreement(house1, house2, command[3])
+		} else if command[0] == "mediate" {
+			if len(command) < 2 {
+				fmt.Printf("Specify a house(mediate <house-name>)\n")
+				continue
+			}
+			house := FindHouseByName(command[1])
+			if house == nil {
+				fmt.Printf("Could not find house '%s'\n", command[1])
+				continue
+			}
+			MediateWar(house)
+		} else if command[0] == "charity" {
+			if len(command) < 2 {
+				fmt.Printf("Specify a house(charity <house-name>)\n")
+				continue
+			}
+			house := FindHouseByName(command[1])
+			if house == nil {
+				fmt.Printf("Could not find house '%s'\n", command[1])
+				continue
+			}
+			GiveCharity(house)
+		} else if command[0] == "events" {
+			DisplayCalamities()
+		} else if command[0] == "map" {
+			DisplayMap()
 		} else if command[0] == "houses" {
 			DisplayHouses()
 		} else if command[0] == "wars" {
@@ -279,6 +526,7 @@ func DoPlayerTurn() {
 			Game.Player.Glory -= gloryCost
 			knight.Blessings++
 			fmt.Printf("%s will now have +%dd in duels.\n", knight.GetTitle(), knight.Blessings)
+			BlessingRaisesMorale(knight)
 		}
 	}
 }
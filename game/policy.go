@@ -0,0 +1,190 @@
+package game
+
+import (
+	"fmt"
+	"strings"
+)
+
+// WarFactor is one consideration that pushed a house towards or away from war.
+type WarFactor struct {
+	Name  string
+	Value int
+}
+
+// WarDecision is a house's reasoning about whether to declare war on another.
+type WarDecision struct {
+	House  *House
+	Target *House
+	Cycle  int
+
+	Factors   []*WarFactor
+	Threshold int
+	// Veto is set when the house refused to consider war at all.
+	Veto string
+}
+
+func NewWarDecision(house *House, target *House, threshold int) *WarDecision {
+	return &WarDecision{
+		House:     house,
+		Target:    target,
+		Cycle:     Game.Cycle,
+		Factors:   make([]*WarFactor, 0),
+		Threshold: threshold,
+	}
+}
+
+func (decision *WarDecision) AddFactor(name string, value int) {
+	if value != 0 {
+		decision.Factors = append(decision.Factors, &WarFactor{Name: name, Value: value})
+	}
+}
+
+func (decision *WarDecision) GetScore() int {
+	score := 0
+	for _, factor := range decision.Factors {
+		score += factor.Value
+	}
+	return score
+}
+
+func (decision *WarDecision) WillDeclare() bool {
+	return decision.Veto == "" && decision.GetScore() >= decision.Threshold
+}
+
+// GetExplanation describes why the house did or didn't go to war.
+func (decision *WarDecision) GetExplanation() string {
+	verdict := "decided against war with"
+	if decision.WillDeclare() {
+		verdict = "chose war with"
+	}
+	if decision.Veto != "" {
+		return fmt.Sprintf("%s %s %s: %s.", decision.House.GetTitle(), verdict, decision.Target.GetTitle(), decision.Veto)
+	}
+
+	factorTexts := make([]string, 0, len(decision.Factors))
+	for _, factor := range decision.Factors {
+		factorTexts = append(factorTexts, fmt.Sprintf("%s %+d", factor.Name, factor.Value))
+	}
+	return fmt.Sprintf(
+		"%s %s %s: %s[%d vs %d].",
+		decision.House.GetTitle(), verdict, decision.Target.GetTitle(),
+		strings.Join(factorTexts, ", "), decision.GetScore(), decision.Threshold,
+	)
+}
+
+/**
+ * WarPolicy decides whether a house goes to war with a house it holds a
+ * grievance against.
+ * NOTE: Defined as an interface so different policies can be compared in the
+ * simulator.
+ */
+type WarPolicy interface {
+	ConsiderWar(house *House, target *House, casusBelli *Grievance) *WarDecision
+}
+
+// WarPolicies are the policies houses can use, by name.
+var WarPolicies = map[string]WarPolicy{
+	"tension": &TensionWarPolicy{},
+	"utility": &UtilityWarPolicy{},
+}
+
+var DefaultWarPolicy = "utility"
+
+// TensionWarPolicy is the old way of going to war. Houses at peace go to war
+// when their tension overcomes the might of the target, with no thought for
+// allies or coin.
+type TensionWarPolicy struct{}
+
+func (policy *TensionWarPolicy) ConsiderWar(house *House, target *House, casusBelli *Grievance) *WarDecision {
+	caution := 3
+
+	decision := NewWarDecision(house, target, 0)
+	if house.NumWars() > 0 {
+		decision.Veto = "already at war"
+		return decision
+	}
+
+	decision.AddFactor("tension", RollHits(house.DiplomaticRelations[target].GetTension()))
+	decision.AddFactor("target might", -target.GetMight())
+	decision.AddFactor("caution", -caution)
+	decision.AddFactor("distance", -Max(0, GetHouseDistance(house, target) - 1))
+	if GetTreaty(house, target) != nil {
+		decision.AddFactor("treaty", -Max(0, 3 + GetTraitModifier(house.Personality.Honour)))
+	}
+	decision.AddFactor("aggression", GetTraitModifier(house.Personality.Aggression))
+	return decision
+}
+
+// UtilityWarPolicy weighs everything a sensible lord would before going to
+// war: how hard the two sides could hit each other with their friends, whether
+// the coffers can bear it, what other wars are already being fought and the
+// character of the house.
+// NOTE: The threshold is tuned so the realm sees about one war declared every
+// four years, as it did before tensions cooled: 12-18 wars in
+// `-simulate 60 -runs 100 -policy all`, with fewer of them against far weaker
+// houses than under the tension policy. Re-run it after changing how tension
+// builds or cools, or any of the factors below.
+type UtilityWarPolicy struct{}
+
+func (policy *UtilityWarPolicy) ConsiderWar(house *House, target *House, casusBelli *Grievance) *WarDecision {
+	threshold := 14
+	warCountPenalty := 3
+	// A full war chest only emboldens a house a little, but an empty one gives it pause.
+	maxEconomyBonus := 1
+	maxEconomyPenalty := 3
+	maxStrengthBonus := 2
+
+	decision := NewWarDecision(house, target, threshold)
+
+	// Nothing drives a house to war like hatred.
+	decision.AddFactor("tension", 2 * RollHits(house.DiplomaticRelations[target].GetTension()))
+	decision.AddFactor("cause", casusBelli.Type.Zeal)
+
+	// Compare the strength of the house and its likely friends against the target's.
+	// Crushing a far weaker house wins a house little it couldn't win in a fairer fight.
+	decision.AddFactor("strength", Min(house.GetMight() - target.GetMight(), maxStrengthBonus))
+	// Houses can't be sure their friends will answer the call.
+	decision.AddFactor("expected allies", (EstimateAlliedMight(house, target) - EstimateAlliedMight(target, house)) / 2)
+
+	surplus := GetIncome(house) - GetUpkeep(house) - WarCostPerYear
+	economy := surplus / 2 + house.Coin / CoinPerWealth
+	decision.AddFactor("economy", Max(-maxEconomyPenalty, Min(economy, maxEconomyBonus)))
+
+	decision.AddFactor("wars", -warCountPenalty * house.NumWars())
+	decision.AddFactor("distance", -Max(0, GetHouseDistance(house, target) - 1))
+	if GetTreaty(house, target) != nil {
+		decision.AddFactor("treaty", -Max(0, 3 + GetTraitModifier(house.Personality.Honour)))
+	}
+
+	decision.AddFactor("aggression", GetTraitModifier(house.Personality.Aggression))
+	if IsTyranny(house, target) {
+		// Every house fears the realm turning on a tyrant, and honourable houses are
+		// ashamed to prey on the weak besides.
+		decision.AddFactor("backlash", -2 * (house.Infamy + 1))
+		decision.AddFactor("shame", -Max(0, GetTraitModifier(house.Personality.Honour)))
+	}
+	return decision
+}
+
+// EstimateAlliedMight guesses how much might would come to the house's aid in
+// a war against the enemy: its vassals, and any house at peace that hates the
+// enemy more than it hates the house.
+func EstimateAlliedMight(house *House, enemy *House) int {
+	alliedMight := 0
+	for _, vassal := range GetVassals(house) {
+		alliedMight += vassal.GetMight()
+	}
+	for _, other := range Game.Houses {
+		if other == house || other == enemy || other.Liege == house || other.NumWars() > 0 {
+			continue
+		}
+		if GetTreaty(other, enemy) != nil || IsFeudalBond(other, enemy) {
+			continue
+		}
+		relativeTension := other.DiplomaticRelations[enemy].GetTension() - other.DiplomaticRelations[house].GetTension()
+		if relativeTension + enemy.Infamy > 1 {
+			alliedMight += other.GetMight()
+		}
+	}
+	return alliedMight
+}
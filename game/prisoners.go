@@ -0,0 +1,139 @@
+package game
+
+import "fmt"
+
+// GetRansom returns the coin a captor asks for the release of a knight.
+func GetRansom(knight *Knight) int {
+	return 5 * knight.Prowess
+}
+
+// ShouldCaptureKnight decides whether the winner of a duel takes the loser
+// prisoner instead of killing them. Close duels and greedy houses favour
+// capture, brave knights would rather die than yield.
+func ShouldCaptureKnight(winner *Knight, loser *Knight, margin int) bool {
+	maxCaptureMargin := 3
+	if margin >= maxCaptureMargin {
+		return false
+	}
+
+	captor := winner.GetAllegiance()
+	if captor == nil {
+		return false
+	}
+
+	yieldPool := captor.GetWealth() + maxCaptureMargin - margin
+	yieldOb := loser.Bravery / 2 + 1
+	// Nobody will pay much for a hedge knight.
+	if loser.IsHedgeKnight() {
+		yieldOb += 2
+	}
+	return RollHits(yieldPool) >= yieldOb
+}
+
+func CaptureKnight(captor *House, knight *Knight) {
+	if knight.House != nil {
+		knight.House.Knights = RemoveItem(knight.House.Knights, knight)
+	}
+	ReleaseKnight(knight)
+
+	knight.Captor = captor
+	knight.CapturedCycle = Game.Cycle
+	captor.Prisoners = append(captor.Prisoners, knight)
+}
+
+// ReleasePrisoner frees a knight and sends them home. Captives never outlive
+// their house, they fall with it.
+func ReleasePrisoner(knight *Knight) {
+	if knight.Captor == nil {
+		return
+	}
+	knight.Captor.Prisoners = RemoveItem(knight.Captor.Prisoners, knight)
+	knight.Captor = nil
+
+	if knight.House != nil {
+		knight.House.Knights = append(knight.House.Knights, knight)
+	}
+}
+
+func ExecutePrisoner(knight *Knight) {
+	tensionIncrease := 3
+
+	captor := knight.Captor
+	fmt.Printf("%s executed their prisoner %s.\n", captor.GetTitle(), knight.GetTitle())
+
+	home := knight.House
+	KillKnight(knight)
+
+	if home != nil {
+		if relation, exists := home.DiplomaticRelations[captor]; exists {
+			relation.AddTension(tensionIncrease, fmt.Sprintf("%s executed %s", captor.GetTitle(), knight.GetTitle()))
+			RecordGrievance(home, captor, ExecutedKnight)
+			fmt.Printf(
+				"%s's tensions with %s increased to %d.\n",
+				home.GetTitle(), captor.GetTitle(), relation.GetTension(),
+			)
+		}
+	}
+}
+
+// PayRansom releases a prisoner back to their house. The payer is charged
+// separately since both houses and the church can pay ransoms.
+func PayRansom(knight *Knight, payerTitle string) {
+	ransom := GetRansom(knight)
+	captor := knight.Captor
+	captor.Coin += ransom
+	fmt.Printf(
+		"%s paid %s a ransom of %d coin for the release of %s.\n",
+		payerTitle, captor.GetTitle(), ransom, knight.GetTitle(),
+	)
+	ReleasePrisoner(knight)
+}
+
+// ResolvePrisoners gives each house the chance to ransom its knights. Captors
+// may execute prisoners from houses they hate, and eventually turn loose the
+// prisoners that nobody will pay for.
+func ResolvePrisoners() {
+	executionOb := 4
+	maxYearsHeld := 3
+
+	for _, captor := range CopySlice(Game.Houses) {
+		for _, prisoner := range CopySlice(captor.Prisoners) {
+			if IsHostage(prisoner) {
+				continue
+			}
+			home := prisoner.House
+			ransom := GetRansom(prisoner)
+
+			if home != nil && home.Coin >= ransom && RollHits(home.GetWealth()) > 0 {
+				home.Coin -= ransom
+				PayRansom(prisoner, home.GetTitle())
+				continue
+			}
+
+			if home != nil {
+				tension := captor.DiplomaticRelations[home].GetTension()
+				if RollHits(tension) >= executionOb {
+					ExecutePrisoner(prisoner)
+					continue
+				}
+			}
+
+			if Game.Cycle - prisoner.CapturedCycle >= maxYearsHeld && home == nil {
+				fmt.Printf("With nobody willing to pay their ransom, %s turned %s loose.\n", captor.GetTitle(), prisoner.GetTitle())
+				ReleasePrisoner(prisoner)
+			}
+		}
+	}
+}
+
+// ExchangePrisoners frees every prisoner that one alliance holds from the other.
+func ExchangePrisoners(captors *Alliance, enemies *Alliance) {
+	for _, captor := range append([]*House{captors.Leader}, captors.Allies...) {
+		for _, prisoner := range CopySlice(captor.Prisoners) {
+			if prisoner.House != nil && HouseIsInAlliance(enemies, prisoner.House) && !IsHostage(prisoner) {
+				fmt.Printf("%s released %s as part of the peace.\n", captor.GetTitle(), prisoner.GetTitle())
+				ReleasePrisoner(prisoner)
+			}
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package game
+
+import "fmt"
+
+// GrudgeThreshold is the size of an incident that is remembered as a grudge
+// rather than a passing irritation.
+var GrudgeThreshold = 3
+
+// GrudgeDecayYears is how many years a grudge must go unstoked before it
+// softens by one.
+var GrudgeDecayYears = 10
+
+// IrritationDecayDivisor is the share of an unstoked irritation that fades each
+// year, e.g. 4 is a quarter. Petty slights too small to fade linger until
+// something is done to soothe them.
+var IrritationDecayDivisor = 4
+
+// Incident is something that moved the tension between two houses.
+type Incident struct {
+	Cycle  int
+	Amount int
+	Cause  string
+}
+
+// DiplomaticRelation is how one house feels about another. Tension is split
+// into grudges held over serious wrongs, which fade over many years, and
+// irritation at petty slights, which is forgotten quickly.
+type DiplomaticRelation struct {
+	Grudge     int
+	Irritation int
+	Incidents  []*Incident
+
+	// Grievances are the wrongs the other house has done this one, any of
+	// which could become the cause of a war.
+	Grievances []*Grievance
+
+	// LastWarDecision is the house's most recent reasoning about going to war
+	// with the other house.
+	LastWarDecision *WarDecision
+}
+
+func (relation *DiplomaticRelation) GetTension() int {
+	return relation.Grudge + relation.Irritation
+}
+
+func (relation *DiplomaticRelation) recordIncident(amount int, cause string) {
+	relation.Incidents = append(relation.Incidents, &Incident{
+		Cycle:  Game.Cycle,
+		Amount: amount,
+		Cause:  cause,
+	})
+}
+
+// AddTension raises the tension over an incident. Serious incidents become
+// grudges, anything less is an irritation.
+func (relation *DiplomaticRelation) AddTension(amount int, cause string) {
+	if amount >= GrudgeThreshold {
+		relation.Grudge += amount
+	} else {
+		relation.Irritation += amount
+	}
+	relation.recordIncident(amount, cause)
+}
+
+// ReduceTension eases the tension, soothing irritation before grudges.
+func (relation *DiplomaticRelation) ReduceTension(amount int, cause string) {
+	oldTension := relation.GetTension()
+	irritationReduction := Min(amount, relation.Irritation)
+	relation.Irritation -= irritationReduction
+	relation.Grudge = Max(0, relation.Grudge - (amount - irritationReduction))
+	if reduction := oldTension - relation.GetTension(); reduction > 0 {
+		relation.recordIncident(-reduction, cause)
+	}
+}
+
+// ClearTension settles everything between the houses.
+func (relation *DiplomaticRelation) ClearTension(cause string) {
+	relation.ReduceTension(relation.GetTension(), cause)
+}
+
+// getLastStokedCycle returns the last year tension was raised between the houses.
+func (relation *DiplomaticRelation) getLastStokedCycle() int {
+	for idx := len(relation.Incidents) - 1; idx >= 0; idx-- {
+		if relation.Incidents[idx].Amount > 0 {
+			return relation.Incidents[idx].Cycle
+		}
+	}
+	return 0
+}
+
+// Cool lets a year pass for the relation. Tensions stoked this year don't
+// cool at all. Otherwise irritation fades by a share of itself, while a grudge
+// only softens after going unstoked for years.
+// NOTE: Cooling any faster than this drains tension quicker than incidents
+// can build it up, and houses stop going to war. Check changes with the simulator.
+func (relation *DiplomaticRelation) Cool() {
+	yearsUnstoked := Game.Cycle - relation.getLastStokedCycle()
+	if yearsUnstoked == 0 {
+		return
+	}
+	relation.Irritation = Max(0, relation.Irritation - relation.Irritation / IrritationDecayDivisor)
+	if yearsUnstoked % GrudgeDecayYears == 0 {
+		relation.Grudge = Max(0, relation.Grudge - 1)
+	}
+}
+
+// CoolTensions lets time heal the relations between every house.
+func CoolTensions() {
+	for _, house := range Game.Houses {
+		for _, relation := range house.DiplomaticRelations {
+			relation.Cool()
+		}
+	}
+}
+
+// DisplayIncidents prints the most recent incidents behind a relation.
+func DisplayIncidents(relation *DiplomaticRelation) {
+	maxIncidents := 5
+
+	incidentStartIdx := Max(0, len(relation.Incidents) - maxIncidents)
+	for _, incident := range relation.Incidents[incidentStartIdx:] {
+		fmt.Printf("  Year %d: %+d %s\n", incident.Cycle, incident.Amount, incident.Cause)
+	}
+}
+
+// TyrannyMightRatio is how many times mightier than its victim a house must be
+// for attacking it to be seen as tyranny.
+var TyrannyMightRatio = 2
+
+// IsTyranny returns whether the attacker is picking on a house far weaker than itself.
+func IsTyranny(attacker *House, defender *House) bool {
+	return attacker.GetMight() >= TyrannyMightRatio * Max(1, defender.GetMight())
+}
+
+// DenounceTyrant has the realm turn against a house that attacked one far
+// weaker than itself. No one likes a tyrant, and every time it happens the
+// house's infamy grows and the realm remembers it more bitterly.
+func DenounceTyrant(tyrant *House, victim *House) {
+	tyrant.Infamy++
+	fmt.Printf(
+		"The realm looked on in disgust as %s fell upon the weak %s[infamy: %d].\n",
+		tyrant.GetTitle(), victim.GetTitle(), tyrant.Infamy,
+	)
+	for _, house := range Game.Houses {
+		if house == tyrant || house == victim {
+			continue
+		}
+		house.DiplomaticRelations[tyrant].AddTension(
+			tyrant.Infamy, fmt.Sprintf("%s tyrannised %s", tyrant.GetTitle(), victim.GetTitle()),
+		)
+	}
+}
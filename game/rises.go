@@ -0,0 +1,151 @@
+package game
+
+import "fmt"
+
+// RiseTension is how much tension a newly risen house and its parent start with.
+var RiseTension = 6
+
+// Secession is the grievance of a house that lost knights and land to an upstart.
+var Secession = &GrievanceType {
+	Name:     "a secession",
+	Zeal:     2,
+	Sympathy: -1,
+	Claim:    Vassalage,
+}
+
+// DoRisings gives houses the chance to rise from within the great houses.
+// Bannermen crushed by tribute, knights fresh from famous victories and
+// peasants whose lands have been ravaged by war may all break away from their
+// house. Only houses with land to spare can lose some of it.
+func DoRisings() {
+	for _, house := range CopySlice(Game.Houses) {
+		if len(GetHeldRegions(house)) < 2 {
+			continue
+		}
+		if RiseOfOvertaxedBannermen(house) || RiseOfAmbitiousKnight(house) {
+			continue
+		}
+		RiseOfPeasants(house)
+	}
+}
+
+// GetTributeBurden returns the coin a house hands over each year to its liege
+// and to the victors of the wars it lost.
+func GetTributeBurden(house *House) int {
+	burden := 0
+	if house.Liege != nil {
+		burden += GetVassalTribute(house)
+	}
+	for _, treaty := range Game.Treaties {
+		if treaty.Vanquished == house && treaty.HasTerm(Tribute) {
+			burden += treaty.GetTribute()
+		}
+	}
+	return burden
+}
+
+// RiseOfOvertaxedBannermen has the bannermen of a house squeezed for tribute
+// it can't afford break away under one of its knights.
+func RiseOfOvertaxedBannermen(house *House) bool {
+	revoltOb := 3
+
+	burden := GetTributeBurden(house)
+	leaders := GetHeirs(house)
+	if burden == 0 || len(leaders) == 0 {
+		return false
+	}
+	// Houses with full coffers can pay their dues without squeezing their bannermen.
+	hardship := burden + Max(0, GetUpkeep(house) - GetIncome(house)) - house.Coin / CoinPerWealth
+	if RollHits(hardship) < revoltOb {
+		return false
+	}
+
+	leader := RandomSelect(leaders)
+	followers := make([]*Knight, 0)
+	for _, knight := range leaders {
+		if knight != leader && RandomRange(0, 2) == 0 {
+			followers = append(followers, knight)
+		}
+	}
+	RiseFrom(house, leader, followers, fmt.Sprintf("bannermen overtaxed by %s", house.GetTitle()))
+	return true
+}
+
+// RiseOfAmbitiousKnight has a knight of the house whose victories have made
+// them more famous than their lord strike out on their own.
+func RiseOfAmbitiousKnight(house *House) bool {
+	famousReputation := 1.7
+	famousKills := 2
+	ambitionOb := 4
+
+	if house.Lord == nil {
+		return false
+	}
+	for _, knight := range GetHeirs(house) {
+		isFamous := knight.GetRecentReputation() >= famousReputation && len(knight.SlayedKnights) >= famousKills
+		if !isFamous || GetClaimStrength(knight) <= GetClaimStrength(house.Lord) {
+			continue
+		}
+		if RollHits(knight.Bravery) < ambitionOb {
+			continue
+		}
+
+		followers := make([]*Knight, 0)
+		for _, follower := range GetHeirs(house) {
+			if follower != knight && RandomRange(0, 3) == 0 {
+				followers = append(followers, follower)
+			}
+		}
+		RiseFrom(house, knight, followers, "the famous victories of its leader")
+		return true
+	}
+	return false
+}
+
+// RiseOfPeasants has the peasants of a house ravaged by war revolt and take
+// their land for themselves, won over by one of the house's own knights. A
+// wandering hedge knight leads them if one can be found, otherwise a hero rises
+// from among them.
+func RiseOfPeasants(house *House) bool {
+	revoltOb := 3
+
+	defectors := GetHeirs(house)
+	if house.NumWars() == 0 || len(defectors) == 0 {
+		return false
+	}
+	occupiedRegions := len(GetHouseRegions(house)) - len(GetHeldRegions(house))
+	// Levies lost in battle are sons and fathers that never came home.
+	lostLevies := Max(0, GetLevyCapacity(house) - house.Army.Levies) / LeviesPerMight
+	misery := house.NumWars() + occupiedRegions + lostLevies
+	if RollHits(misery) < revoltOb {
+		return false
+	}
+
+	var leader *Knight
+	if wanderers := GetWanderingHedgeKnights(); len(wanderers) > 0 {
+		leader = RandomSelect(wanderers)
+	} else {
+		leader = GenerateKnight(nil)
+	}
+	followers := []*Knight{RandomSelect(defectors)}
+	RiseFrom(house, leader, followers, fmt.Sprintf("a peasant revolt in the war-torn lands of %s", house.GetTitle()))
+	return true
+}
+
+// RiseFrom founds a new house out of the parent, led by the leader and taking
+// the followers, land, levies and coin from its parent. The upstarts take
+// their own name and banner, and they and their parent start off bitter.
+func RiseFrom(parent *House, leader *Knight, followers []*Knight, cause string) *House {
+	house := SplitHouse(parent, leader, followers)
+	house.Banner = GenerateBanner()
+	house.Personality = GeneratePersonality()
+	fmt.Printf(
+		"%s rose from %s through %s, led by %s with %d knight(s)! Their banner is %s.\n",
+		house.GetTitle(), parent.GetTitle(), cause, leader.GetTitle(), len(followers), house.Banner.GetDescription(),
+	)
+
+	house.DiplomaticRelations[parent].AddTension(RiseTension, fmt.Sprintf("broke away from %s", parent.GetTitle()))
+	parent.DiplomaticRelations[house].AddTension(RiseTension, fmt.Sprintf("%s broke away", house.GetTitle()))
+	RecordGrievance(parent, house, Secession)
+	return house
+}
@@ -0,0 +1,190 @@
+package game
+
+import "fmt"
+
+var MaxFortification = 5
+
+type Castle struct {
+	Fortification int
+}
+
+// GetUpgradeCost returns the coin needed to raise the castle's walls by one level.
+func (castle *Castle) GetUpgradeCost() int {
+	return 10 * (castle.Fortification + 1)
+}
+
+// Siege is a house that has retreated into its castle after losing too many
+// battles. The siege ends when the besiegers storm the castle, the defenders
+// starve, or the besiegers lose heart.
+type Siege struct {
+	Besieger *House
+	Defender *House
+
+	// Morale is the besiegers' will to keep the siege going.
+	Morale   int
+	Supplies int
+	// Length is the number of campaigns the siege has lasted, up to three a year.
+	Length   int
+}
+
+// SiegeDefeatThreshold is the number of battles in a row a house can lose
+// before it retreats behind its walls.
+var SiegeDefeatThreshold = 2
+
+func (war *War) GetSiege(house *House) *Siege {
+	for _, siege := range war.Sieges {
+		if siege.Defender == house {
+			return siege
+		}
+	}
+	return nil
+}
+
+func (war *War) IsBesieged(house *House) bool {
+	return war.GetSiege(house) != nil
+}
+
+func (war *War) StartSiege(besieger *House, defender *House) {
+	siege := &Siege{
+		Besieger: besieger,
+		Defender: defender,
+		Morale:   war.GetAlliance(besieger).Morale,
+		// Richer houses have fuller larders.
+		Supplies: 2 * defender.GetWealth(),
+		Length:   0,
+	}
+	war.Sieges = append(war.Sieges, siege)
+	fmt.Printf(
+		"%s retreated behind their walls[fortification: %d] and %s laid siege to them!\n",
+		defender.GetTitle(), defender.Castle.Fortification, besieger.GetTitle(),
+	)
+}
+
+// RecordBattleResult tracks how many battles in a row a defending house has
+// lost, sending it into a siege once it has lost too many.
+func (war *War) RecordBattleResult(attacker *House, defender *House, attackerMargin int) {
+	if attackerMargin <= 0 {
+		war.defeatStreaks[defender] = 0
+		return
+	}
+
+	war.defeatStreaks[defender]++
+	if war.defeatStreaks[defender] >= SiegeDefeatThreshold && !war.IsBesieged(defender) {
+		war.defeatStreaks[defender] = 0
+		war.StartSiege(attacker, defender)
+	}
+}
+
+func (war *War) EndSiege(siege *Siege) {
+	war.Sieges = RemoveItem(war.Sieges, siege)
+}
+
+// DoSieges advances every siege in the war by one campaign.
+func (war *War) DoSieges() {
+	for _, siege := range CopySlice(war.Sieges) {
+		besiegerFled := !Exists(Game.Houses, siege.Besieger) || war.GetAlliance(siege.Besieger) == nil
+		defenderFled := !Exists(Game.Houses, siege.Defender) || war.GetAlliance(siege.Defender) == nil
+		if besiegerFled || defenderFled {
+			war.EndSiege(siege)
+			continue
+		}
+		war.DoSiegeTurn(siege)
+		fmt.Printf("\n")
+	}
+}
+
+func (war *War) DoSiegeTurn(siege *Siege) {
+	stormMorale := 2
+	stormMoraleLoss := 2
+	starvationMoraleLoss := 4
+	// Besiegers dig in and build their ladders before they storm the walls.
+	minStormLength := 2
+
+	besiegers := war.GetAlliance(siege.Besieger)
+	defenders := war.GetAlliance(siege.Defender)
+	fortification := siege.Defender.Castle.Fortification
+
+	siege.Length++
+	siege.Supplies--
+	siege.Morale--
+	fmt.Printf(
+		"%s's siege of %s drags on[supplies: %d, siege morale: %d].\n",
+		siege.Besieger.GetTitle(), siege.Defender.GetTitle(), siege.Supplies, siege.Morale,
+	)
+
+	if siege.Supplies <= 0 {
+		fmt.Printf(
+			"%s ran out of supplies and opened its gates to %s!\n",
+			siege.Defender.GetTitle(), siege.Besieger.GetTitle(),
+		)
+		if region := ChooseBattlefield(siege.Besieger, siege.Defender); region != nil {
+			OccupyRegion(siege.Besieger, region)
+		}
+		war.ChangeMorale(defenders, -starvationMoraleLoss, fmt.Sprintf("%s was starved out", siege.Defender.GetTitle()))
+		war.EndSiege(siege)
+		return
+	}
+
+	if siege.Morale <= 0 {
+		fmt.Printf("%s lost heart and lifted the siege of %s.\n", siege.Besieger.GetTitle(), siege.Defender.GetTitle())
+		war.ChangeMorale(besiegers, -1, fmt.Sprintf("the siege of %s was lifted", siege.Defender.GetTitle()))
+		war.EndSiege(siege)
+		return
+	}
+
+	if siege.Length < minStormLength {
+		return
+	}
+	// Besiegers storm the walls when they are confident, or desperate.
+	isConfident := RollHits(siege.Besieger.GetMight()) > fortification + 1
+	isDesperate := siege.Morale <= stormMorale
+	if !isConfident && !isDesperate {
+		return
+	}
+
+	fmt.Printf("%s stormed the walls of %s!\n", siege.Besieger.GetTitle(), siege.Defender.GetTitle())
+	report := RunBattle(
+		war.GatherCoalition(siege.Besieger, besiegers), []*House{siege.Defender}, fortification,
+	)
+	war.ApplyBattleMorale(besiegers, defenders, report)
+	if report.AttackerMargin > 0 {
+		fmt.Printf("The walls of %s were breached!\n", siege.Defender.GetTitle())
+		siege.Defender.Castle.Fortification = Max(0, fortification - 1)
+		war.ChangeMorale(defenders, -fortification, fmt.Sprintf("the walls of %s fell", siege.Defender.GetTitle()))
+		war.EndSiege(siege)
+		return
+	}
+
+	// A repelled assault costs the besiegers heart, but they stay camped outside the walls.
+	fmt.Printf("The assault was thrown back from the walls.\n")
+	siege.Morale -= stormMoraleLoss
+	war.ChangeMorale(besiegers, -stormMoraleLoss, fmt.Sprintf("the storming of %s failed", siege.Defender.GetTitle()))
+}
+
+// UpgradeCastles lets houses at peace spend their coin on stronger walls. Weak
+// houses are the most eager to hide behind stone.
+func UpgradeCastles() {
+	upgradeChance := 2
+
+	for _, house := range Game.Houses {
+		if house.NumWars() > 0 || house.Castle.Fortification >= MaxFortification {
+			continue
+		}
+
+		cost := house.Castle.GetUpgradeCost()
+		if house.Coin < cost {
+			continue
+		}
+		isWeak := house.GetMight() <= 2
+		if !isWeak && RandomRange(0, upgradeChance) != 0 {
+			continue
+		}
+
+		house.Coin -= cost
+		house.Castle.Fortification++
+		fmt.Printf(
+			"%s spent %d coin raising its walls to fortification %d.\n",
+			house.GetTitle(), cost, house.Castle.Fortification,
+		)
+	}
+}
@@ -0,0 +1,169 @@
+package game
+
+import "fmt"
+
+// GetHeldRegions returns the regions a house owns that aren't occupied by an enemy.
+func GetHeldRegions(house *House) []*Region {
+	regions := make([]*Region, 0)
+	for _, region := range GetHouseRegions(house) {
+		if region.Occupier == nil {
+			regions = append(regions, region)
+		}
+	}
+	return regions
+}
+
+// GetOccupiedRegions returns the regions of other houses that a house is occupying.
+func GetOccupiedRegions(house *House) []*Region {
+	regions := make([]*Region, 0)
+	for _, region := range Game.Map.Regions {
+		if region.Occupier == house {
+			regions = append(regions, region)
+		}
+	}
+	return regions
+}
+
+// GetControlledRegions returns the regions a house holds plus the regions it occupies.
+func GetControlledRegions(house *House) []*Region {
+	return append(GetHeldRegions(house), GetOccupiedRegions(house)...)
+}
+
+// ChooseBattlefield picks the region of the defender that the attacker marches
+// on. Attackers prefer regions bordering land they already control. Returns nil
+// if the defender holds no land.
+func ChooseBattlefield(attacker *House, defender *House) *Region {
+	defenderRegions := GetHeldRegions(defender)
+	if len(defenderRegions) == 0 {
+		return nil
+	}
+
+	for _, region := range RandomizeOrder(defenderRegions) {
+		for _, neighbour := range region.Neighbours {
+			if neighbour.GetController() == attacker {
+				return region
+			}
+		}
+	}
+	return RandomSelect(defenderRegions)
+}
+
+func OccupyRegion(occupier *House, region *Region) {
+	region.Occupier = occupier
+	fmt.Printf(
+		"%s occupied %s, %s lands of %s.\n",
+		occupier.GetTitle(), region.Name, region.Terrain.Type, region.Owner.GetTitle(),
+	)
+}
+
+// LiberateRegion has a house take back one of its regions occupied by the
+// enemy, if there are any.
+func LiberateRegion(house *House, enemy *House) {
+	for _, region := range GetHouseRegions(house) {
+		if region.Occupier == enemy {
+			region.Occupier = nil
+			fmt.Printf("%s drove %s out of %s.\n", house.GetTitle(), enemy.GetTitle(), region.Name)
+			return
+		}
+	}
+}
+
+func TransferRegion(region *Region, newOwner *House) {
+	fmt.Printf("%s passed from %s to %s.\n", region.Name, region.Owner.GetTitle(), newOwner.GetTitle())
+	region.Owner = newOwner
+	region.Occupier = nil
+}
+
+// SettleOccupations hands occupied regions over at the end of a war. The
+// occupations of the victors become their land, while the regions the
+// defeated occupied are returned to their owners. Passing isTruce lets both
+// sides keep what they occupy.
+func SettleOccupations(victors *Alliance, defeated *Alliance, isTruce bool) {
+	for _, region := range Game.Map.Regions {
+		if region.Occupier == nil || region.Owner == nil {
+			continue
+		}
+
+		occupierIsVictor := HouseIsInAlliance(victors, region.Occupier) && HouseIsInAlliance(defeated, region.Owner)
+		occupierIsDefeated := HouseIsInAlliance(defeated, region.Occupier) && HouseIsInAlliance(victors, region.Owner)
+		if !occupierIsVictor && !occupierIsDefeated {
+			continue
+		}
+
+		if occupierIsVictor || isTruce {
+			TransferRegion(region, region.Occupier)
+		} else {
+			fmt.Printf("%s returned %s to %s.\n", region.Occupier.GetTitle(), region.Name, region.Owner.GetTitle())
+			region.Occupier = nil
+		}
+	}
+}
+
+// ReturnOccupations hands the regions either alliance occupies from the other
+// back to their owners.
+func ReturnOccupations(alliance1 *Alliance, alliance2 *Alliance) {
+	for _, region := range Game.Map.Regions {
+		if region.Occupier == nil || region.Owner == nil {
+			continue
+		}
+
+		occupiedBy1 := HouseIsInAlliance(alliance1, region.Occupier) && HouseIsInAlliance(alliance2, region.Owner)
+		occupiedBy2 := HouseIsInAlliance(alliance2, region.Occupier) && HouseIsInAlliance(alliance1, region.Owner)
+		if occupiedBy1 || occupiedBy2 {
+			fmt.Printf("%s returned %s to %s.\n", region.Occupier.GetTitle(), region.Name, region.Owner.GetTitle())
+			region.Occupier = nil
+		}
+	}
+}
+
+// ReturnHouseOccupations hands back the regions a house leaving a war occupies
+// from its enemies, and the regions its enemies occupy from it.
+func ReturnHouseOccupations(house *House, enemies *Alliance) {
+	for _, region := range Game.Map.Regions {
+		if region.Occupier == nil || region.Owner == nil {
+			continue
+		}
+
+		occupiedByHouse := region.Occupier == house && HouseIsInAlliance(enemies, region.Owner)
+		occupiedFromHouse := region.Owner == house && HouseIsInAlliance(enemies, region.Occupier)
+		if occupiedByHouse || occupiedFromHouse {
+			fmt.Printf("%s returned %s to %s.\n", region.Occupier.GetTitle(), region.Name, region.Owner.GetTitle())
+			region.Occupier = nil
+		}
+	}
+}
+
+// FallIfLandless destroys a house that has lost all of its land. A new house
+// rises to take its place if there are wilds left to claim.
+func FallIfLandless(house *House) bool {
+	if !Exists(Game.Houses, house) || len(GetHouseRegions(house)) > 0 {
+		return false
+	}
+
+	fmt.Printf("%s has lost all of its lands and falls out of power.\n", house.GetTitle())
+	DestroyHouse(house)
+	if len(GetHouseRegions(nil)) > 0 {
+		newHouse := GenerateHouse()
+		fmt.Printf("%s rises to power.\n", newHouse.GetTitle())
+	}
+	return true
+}
+
+// CedeRegion has the loser of a war hand one of its regions to the winner,
+// preferring land on the winner's border.
+func CedeRegion(winner *House, loser *House) {
+	loserRegions := GetHeldRegions(loser)
+	if len(loserRegions) == 0 {
+		return
+	}
+
+	cededRegion := RandomSelect(loserRegions)
+	for _, region := range RandomizeOrder(loserRegions) {
+		for _, neighbour := range region.Neighbours {
+			if neighbour.Owner == winner {
+				cededRegion = region
+			}
+		}
+	}
+	TransferRegion(cededRegion, winner)
+}
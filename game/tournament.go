@@ -0,0 +1,184 @@
+package game
+
+import "fmt"
+
+// Tournament is a peacetime contest of jousts. Tournaments hosted by the
+// church have a nil Host.
+type Tournament struct {
+	Host  *House
+	Prize int
+}
+
+func (tournament *Tournament) GetHostTitle() string {
+	if tournament.Host == nil {
+		return "The Church"
+	}
+	return tournament.Host.GetTitle()
+}
+
+// ChurchTournamentCost is the coin the church pays to host a tournament, on top of the prize.
+var ChurchTournamentCost = 10
+
+func HostTournament(host *House, prize int) *Tournament {
+	tournament := &Tournament{
+		Host:  host,
+		Prize: prize,
+	}
+	Game.Tournaments = append(Game.Tournaments, tournament)
+	return tournament
+}
+
+// ChooseTournamentEntrants rolls the bravery of every knight that isn't busy at
+// war to see who is brash enough to ride in the lists.
+func ChooseTournamentEntrants() []*Knight {
+	minBraveryHits := 2
+
+	entrants := make([]*Knight, 0)
+	for _, knight := range Game.Knights {
+		if knight.Captor != nil {
+			continue
+		}
+		allegiance := knight.GetAllegiance()
+		if allegiance != nil && allegiance.NumWars() > 0 {
+			continue
+		}
+		if RollHits(knight.Bravery) >= minBraveryHits {
+			entrants = append(entrants, knight)
+		}
+	}
+	return entrants
+}
+
+// RunJoust pits two knights against each other and returns the winner and
+// loser. Jousts are rarely lethal, but a big enough margin can still kill.
+func RunJoust(knight1 *Knight, knight2 *Knight) (*Knight, *Knight) {
+	lethalMargin := 3
+	lethalChance := 10
+
+	// NOTE: Blessings aren't used in jousts, the gods save their favour for the battlefield.
+	knight1Hits := RollHits(knight1.Prowess)
+	knight2Hits := RollHits(knight2.Prowess)
+	for knight1Hits == knight2Hits {
+		knight1Hits = RollHits(knight1.Prowess)
+		knight2Hits = RollHits(knight2.Prowess)
+	}
+
+	var winner, loser *Knight
+	var winnerHits, loserHits int
+	if knight1Hits > knight2Hits {
+		winner, winnerHits, loser, loserHits = knight1, knight1Hits, knight2, knight2Hits
+	} else {
+		winner, winnerHits, loser, loserHits = knight2, knight2Hits, knight1, knight1Hits
+	}
+
+	if winnerHits - loserHits >= lethalMargin && RandomRange(0, lethalChance) == 0 {
+		fmt.Printf(
+			"%s's lance shattered through %s's visor, killing them in the lists[%d/%dd vs %d/%dd]!\n",
+			winner.GetTitle(), loser.GetTitle(),
+			winnerHits, winner.Prowess, loserHits, loser.Prowess,
+		)
+		KillKnight(loser)
+	} else {
+		fmt.Printf(
+			"%s unhorsed %s[%d/%dd vs %d/%dd].\n",
+			winner.GetTitle(), loser.GetTitle(),
+			winnerHits, winner.Prowess, loserHits, loser.Prowess,
+		)
+	}
+
+	if winner.Sponsor != nil {
+		glory := loser.Prowess
+		Game.Player.Glory += glory
+		fmt.Printf("The Church earned %d glory for sponsoring %s.\n", glory, winner.GetTitle())
+	}
+
+	return winner, loser
+}
+
+func (tournament *Tournament) Run() {
+	championGlory := 10
+
+	entrants := ChooseTournamentEntrants()
+	fmt.Printf("%s hosted a tournament with a prize of %d coin!\n", tournament.GetHostTitle(), tournament.Prize)
+	if len(entrants) < 2 {
+		fmt.Printf("Too few knights rode to the tournament and it was called off.\n\n")
+		if tournament.Host == nil {
+			Game.Player.Coin += tournament.Prize
+		} else {
+			tournament.Host.Coin += tournament.Prize
+		}
+		return
+	}
+
+	if tournament.Host == nil {
+		Game.Player.Glory += len(entrants)
+		fmt.Printf("The Church earned %d glory for hosting %d knights.\n", len(entrants), len(entrants))
+	}
+
+	// Run a single elimination bracket, a knight without an opponent gets a bye.
+	var finalist *Knight
+	bracket := RandomizeOrder(entrants)
+	for len(bracket) > 1 {
+		nextRound := make([]*Knight, 0, len(bracket) / 2 + 1)
+		for idx := 0; idx + 1 < len(bracket); idx += 2 {
+			winner, loser := RunJoust(bracket[idx], bracket[idx + 1])
+			nextRound = append(nextRound, winner)
+			finalist = loser
+		}
+		if len(bracket) % 2 == 1 {
+			nextRound = append(nextRound, bracket[len(bracket) - 1])
+		}
+		bracket = nextRound
+	}
+	champion := bracket[0]
+
+	champion.BattleResults = append(champion.BattleResults, Victory)
+	if Exists(Game.Knights, finalist) {
+		finalist.BattleResults = append(finalist.BattleResults, Defeat)
+	}
+
+	fmt.Printf("%s was crowned champion of the tournament!\n", champion.GetTitle())
+	if champion.Sponsor != nil {
+		champion.Sponsor.Coin += tournament.Prize
+		Game.Player.Glory += championGlory
+		fmt.Printf(
+			"The Church earned %d coin and %d glory for sponsoring %s.\n",
+			tournament.Prize, championGlory, champion.GetTitle(),
+		)
+	} else if allegiance := champion.GetAllegiance(); allegiance != nil {
+		allegiance.Coin += tournament.Prize
+		fmt.Printf("%s won %d coin for %s.\n", champion.GetTitle(), tournament.Prize, allegiance.GetTitle())
+	} else {
+		fmt.Printf("%s rode off with the %d coin purse.\n", champion.GetTitle(), tournament.Prize)
+	}
+	fmt.Printf("\n")
+}
+
+// HouseHostsTournament gives a wealthy house at peace the chance to host a tournament.
+func HouseHostsTournament() {
+	hostChance := 3
+	minCoin := 10
+
+	if RandomRange(0, hostChance) != 0 {
+		return
+	}
+
+	for _, house := range RandomizeOrder(Game.Houses) {
+		if house.NumWars() > 0 || house.Coin < minCoin {
+			continue
+		}
+		prize := house.Coin / 2
+		house.Coin -= prize
+		HostTournament(house, prize)
+		return
+	}
+}
+
+// RunTournaments runs every tournament hosted this year.
+func RunTournaments() {
+	HouseHostsTournament()
+	for _, tournament := range Game.Tournaments {
+		tournament.Run()
+	}
+	Game.Tournaments = make([]*Tournament, 0)
+}
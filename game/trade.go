@@ -0,0 +1,156 @@
+package game
+
+import "fmt"
+
+// TradeAgreementIncome is the coin each side of a trade agreement earns a year.
+var TradeAgreementIncome = 2
+
+var TradeBrokerCoinCost = 10
+var TradeBrokerGloryCost = 20
+
+// TradeAgreement lets merchants travel freely between two houses. Both grow
+// richer and the ties between them slowly ease their tensions.
+type TradeAgreement struct {
+	House1     *House
+	House2     *House
+	StartCycle int
+}
+
+func (agreement *TradeAgreement) IsParty(house *House) bool {
+	return agreement.House1 == house || agreement.House2 == house
+}
+
+func (agreement *TradeAgreement) GetPartner(house *House) *House {
+	if agreement.House1 == house {
+		return agreement.House2
+	}
+	return agreement.House1
+}
+
+// GetTradeAgreement returns the agreement between two houses, or nil if they have none.
+func GetTradeAgreement(house1 *House, house2 *House) *TradeAgreement {
+	for _, agreement := range Game.TradeAgreements {
+		if agreement.IsParty(house1) && agreement.IsParty(house2) {
+			return agreement
+		}
+	}
+	return nil
+}
+
+func GetTradeAgreements(house *House) []*TradeAgreement {
+	agreements := make([]*TradeAgreement, 0)
+	for _, agreement := range Game.TradeAgreements {
+		if agreement.IsParty(house) {
+			agreements = append(agreements, agreement)
+		}
+	}
+	return agreements
+}
+
+func GetAgreementIncome(house *House) int {
+	return TradeAgreementIncome * len(GetTradeAgreements(house))
+}
+
+func SignTradeAgreement(house1 *House, house2 *House) {
+	Game.TradeAgreements = append(Game.TradeAgreements, &TradeAgreement{
+		House1:     house1,
+		House2:     house2,
+		StartCycle: Game.Cycle,
+	})
+	fmt.Printf("%s and %s signed a trade agreement.\n", house1.GetTitle(), house2.GetTitle())
+}
+
+// CancelTradeAgreement ends the agreement between two houses if they have one.
+func CancelTradeAgreement(house1 *House, house2 *House) {
+	agreement := GetTradeAgreement(house1, house2)
+	if agreement == nil {
+		return
+	}
+	Game.TradeAgreements = RemoveItem(Game.TradeAgreements, agreement)
+	fmt.Printf("The trade agreement between %s and %s was cancelled.\n", house1.GetTitle(), house2.GetTitle())
+}
+
+// FormTradeAgreements gives nearby houses at peace the chance to open their
+// roads to each other's merchants. Rich houses have the most to trade, and
+// tension makes houses wary of letting the other's people in.
+func FormTradeAgreements() {
+	maxDistance := 2
+	agreementOb := 3
+
+	for _, house := range RandomizeOrder(Game.Houses) {
+		for _, partner := range RandomizeOrder(Game.Houses) {
+			if house == partner || GetTradeAgreement(house, partner) != nil || HousesAreAtWar(house, partner) {
+				continue
+			}
+			if GetHouseDistance(house, partner) > maxDistance {
+				continue
+			}
+
+			tension := house.DiplomaticRelations[partner].GetTension() + partner.DiplomaticRelations[house].GetTension()
+			tradeHits := RollHits(house.GetWealth() + partner.GetWealth()) - tension
+			if tradeHits >= agreementOb {
+				SignTradeAgreement(house, partner)
+			}
+		}
+	}
+}
+
+// UpholdTradeAgreements eases the tensions between trading partners, and
+// breaks the agreements between houses that have come to hate each other or
+// gone to war.
+func UpholdTradeAgreements() {
+	breakOb := 3
+
+	for _, agreement := range CopySlice(Game.TradeAgreements) {
+		house1, house2 := agreement.House1, agreement.House2
+		if HousesAreAtWar(house1, house2) {
+			fmt.Printf("War between %s and %s put an end to their trade.\n", house1.GetTitle(), house2.GetTitle())
+			Game.TradeAgreements = RemoveItem(Game.TradeAgreements, agreement)
+			continue
+		}
+
+		relation1 := house1.DiplomaticRelations[house2]
+		relation2 := house2.DiplomaticRelations[house1]
+		if RollHits(Max(relation1.GetTension(), relation2.GetTension())) >= breakOb {
+			CancelTradeAgreement(house1, house2)
+			continue
+		}
+
+		relation1.ReduceTension(1, fmt.Sprintf("traded with %s", house2.GetTitle()))
+		relation2.ReduceTension(1, fmt.Sprintf("traded with %s", house1.GetTitle()))
+	}
+}
+
+// BrokerTradeAgreement has the church bring two houses together to trade.
+func BrokerTradeAgreement(house1 *House, house2 *House, payment string) {
+	if house1 == house2 {
+		fmt.Printf("A house can't trade with itself\n")
+		return
+	}
+	if HousesAreAtWar(house1, house2) {
+		fmt.Printf("%s and %s are at war, they refuse to trade.\n", house1.GetTitle(), house2.GetTitle())
+		return
+	}
+	if GetTradeAgreement(house1, house2) != nil {
+		fmt.Printf("%s and %s already trade with each other\n", house1.GetTitle(), house2.GetTitle())
+		return
+	}
+
+	if payment == "coin" {
+		if Game.Player.Coin < TradeBrokerCoinCost {
+			fmt.Printf("Brokering a trade agreement costs %d coin, you only have %d.\n", TradeBrokerCoinCost, Game.Player.Coin)
+			return
+		}
+		Game.Player.Coin -= TradeBrokerCoinCost
+	} else if payment == "glory" {
+		if Game.Player.Glory < TradeBrokerGloryCost {
+			fmt.Printf("Brokering a trade agreement costs %d glory, you only have %d.\n", TradeBrokerGloryCost, Game.Player.Glory)
+			return
+		}
+		Game.Player.Glory -= TradeBrokerGloryCost
+	} else {
+		fmt.Printf("Pay for the trade agreement with coin or glory\n")
+		return
+	}
+	SignTradeAgreement(house1, house2)
+}
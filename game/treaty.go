@@ -0,0 +1,393 @@
+package game
+
+import (
+	"fmt"
+	"strings"
+)
+
+type PeaceTerm = int
+const (
+	PrisonerExchange PeaceTerm = iota
+	Tribute
+	Hostages
+	LandCession
+	Vassalage
+)
+
+var PeaceTermNames = map[PeaceTerm]string{
+	PrisonerExchange: "exchange prisoners",
+	Tribute:          "pay tribute",
+	Hostages:         "hand over hostages",
+	LandCession:      "cede land",
+	Vassalage:        "bend the knee",
+}
+
+// PeaceTermValues is how much each term is worth to the winning side when
+// they weigh up an offer of peace.
+var PeaceTermValues = map[PeaceTerm]int{
+	PrisonerExchange: 0,
+	Tribute:          2,
+	Hostages:         2,
+	LandCession:      3,
+	Vassalage:        5,
+}
+
+// TreatyYears is how long a peace treaty binds the houses that signed it.
+var TreatyYears = 5
+
+// ExhaustedMorale is the morale at which an alliance starts suing for peace.
+var ExhaustedMorale = 3
+
+// MediationCost is the coin the church spends sending envoys to a war.
+var MediationCost = 5
+
+// Treaty is the peace between the leaders of two sides of a war. The
+// vanquished house owes the victor the terms of the treaty until it runs out.
+type Treaty struct {
+	Victor     *House
+	Vanquished *House
+	Terms      []PeaceTerm
+	StartCycle int
+
+	// Hostages are knights of the vanquished house held by the victor to
+	// guarantee the peace.
+	Hostages []*Knight
+}
+
+// ChooseTerms returns the harshest terms that are worth no more than the given
+// value. Terms are given up from least to most severe, and never beyond the
+// harshest term the victor can claim.
+func ChooseTerms(value int, claim PeaceTerm) []PeaceTerm {
+	terms := make([]PeaceTerm, 0)
+	totalValue := 0
+	for _, term := range []PeaceTerm{Tribute, Hostages, LandCession, Vassalage} {
+		if term > claim || totalValue + PeaceTermValues[term] > value {
+			break
+		}
+		totalValue += PeaceTermValues[term]
+		terms = append(terms, term)
+	}
+
+	// With nothing else to give, both sides at least get their knights back.
+	if len(terms) == 0 {
+		terms = append(terms, PrisonerExchange)
+	}
+	return terms
+}
+
+func GetTermsValue(terms []PeaceTerm) int {
+	value := 0
+	for _, term := range terms {
+		value += PeaceTermValues[term]
+	}
+	return value
+}
+
+func GetTermsText(terms []PeaceTerm) string {
+	termNames := make([]string, 0, len(terms))
+	for _, term := range terms {
+		termNames = append(termNames, PeaceTermNames[term])
+	}
+	if len(termNames) == 1 {
+		return termNames[0]
+	}
+	return fmt.Sprintf("%s and %s", strings.Join(termNames[:len(termNames) - 1], ", "), termNames[len(termNames) - 1])
+}
+
+func (treaty *Treaty) HasTerm(term PeaceTerm) bool {
+	return Exists(treaty.Terms, term)
+}
+
+func (treaty *Treaty) IsParty(house *House) bool {
+	return treaty.Victor == house || treaty.Vanquished == house
+}
+
+// GetTribute returns the coin the vanquished house pays each year, a share of
+// its wealth.
+func (treaty *Treaty) GetTribute() int {
+	return Max(1, treaty.Vanquished.GetWealth() / 2)
+}
+
+func (treaty *Treaty) GetEndCycle() int {
+	return treaty.StartCycle + TreatyYears
+}
+
+// GetTreaty returns the treaty binding two houses, or nil if they have none.
+func GetTreaty(house1 *House, house2 *House) *Treaty {
+	for _, treaty := range Game.Treaties {
+		if treaty.IsParty(house1) && treaty.IsParty(house2) {
+			return treaty
+		}
+	}
+	return nil
+}
+
+// IsHostage returns whether the knight is being held to guarantee a treaty.
+// Hostages can't be ransomed or executed while the peace holds.
+func IsHostage(knight *Knight) bool {
+	for _, treaty := range Game.Treaties {
+		if Exists(treaty.Hostages, knight) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetClaim returns the harshest term an alliance can demand if it wins the
+// war. The attackers are limited by the grievance they went to war over,
+// while defenders are free to punish the aggression however they like.
+func (war *War) GetClaim(victors *Alliance) PeaceTerm {
+	if victors == war.Attackers && war.CasusBelli != nil {
+		return war.CasusBelli.Type.Claim
+	}
+	return Vassalage
+}
+
+// GetWarScore returns how well an alliance is doing in the war. Positive
+// scores mean the alliance is winning. Morale counts the most, but land taken
+// and castles besieged strengthen a side's hand at the negotiating table.
+func (war *War) GetWarScore(alliance *Alliance) int {
+	occupationScore := 2
+
+	enemy := war.GetEnemyAlliance(alliance)
+	score := alliance.Morale - enemy.Morale
+	for _, region := range Game.Map.Regions {
+		if region.Occupier == nil || region.Owner == nil {
+			continue
+		}
+		if HouseIsInAlliance(alliance, region.Occupier) && HouseIsInAlliance(enemy, region.Owner) {
+			score += occupationScore
+		} else if HouseIsInAlliance(enemy, region.Occupier) && HouseIsInAlliance(alliance, region.Owner) {
+			score -= occupationScore
+		}
+	}
+	for _, siege := range war.Sieges {
+		if HouseIsInAlliance(alliance, siege.Besieger) {
+			score++
+		} else {
+			score--
+		}
+	}
+	return score
+}
+
+// NegotiatePeace gives an exhausted side the chance to sue for peace. They
+// offer the terms they hope to get away with, and the enemy accepts if the
+// terms are worth as much as their position in the war. Long wars and church
+// mediation make both sides more willing to settle. Returns true if peace was
+// made.
+func (war *War) NegotiatePeace() bool {
+	mediationBonus := 3
+	maxLowball := 3
+
+	isMediated := war.Mediated
+	war.Mediated = false
+
+	// The side worse off sues for peace. With the church mediating neither side
+	// needs to be exhausted to come to the table.
+	suitor := war.Attackers
+	if war.Defenders.Morale < war.Attackers.Morale {
+		suitor = war.Defenders
+	}
+	if suitor.Morale > ExhaustedMorale && !isMediated {
+		return false
+	}
+	victors := war.GetEnemyAlliance(suitor)
+
+	claim := war.GetClaim(victors)
+	demandedValue := GetTermsValue(ChooseTerms(war.GetWarScore(victors), claim))
+	terms := ChooseTerms(demandedValue - RandomRange(0, maxLowball + 1), claim)
+	fmt.Printf(
+		"%s sued %s for peace, offering to %s.\n",
+		suitor.Leader.GetTitle(), victors.Leader.GetTitle(), GetTermsText(terms),
+	)
+
+	// Pious houses listen when the church asks them to make peace, and greedy
+	// houses hold out for more than the war has earned them.
+	wearinessPool := war.GetLength()
+	if isMediated {
+		wearinessPool += Max(0, mediationBonus + GetTraitModifier(victors.Leader.Personality.Piety))
+	}
+	acceptanceOb := Max(0, demandedValue + GetTraitModifier(victors.Leader.Personality.Greed))
+	acceptanceHits := GetTermsValue(terms) + RollHits(wearinessPool)
+	if acceptanceHits < acceptanceOb {
+		fmt.Printf(
+			"%s rejected the terms and fights on[%d vs %d].\n",
+			victors.Leader.GetTitle(), acceptanceHits, acceptanceOb,
+		)
+		return false
+	}
+
+	if isMediated {
+		glory := 2 * (victors.GetTotalMight() + suitor.GetTotalMight())
+		Game.Player.Glory += glory
+		fmt.Printf(
+			"The Church earned %d glory for brokering peace between %s and %s.\n",
+			glory, victors.Leader.GetTitle(), suitor.Leader.GetTitle(),
+		)
+	}
+	war.MakePeace(victors, suitor, terms)
+	return true
+}
+
+// MakePeace ends the war with a treaty. The vanquished always free the
+// prisoners they hold, and the rest of the terms are carried out straight
+// away except for tribute which is paid yearly.
+func (war *War) MakePeace(victors *Alliance, vanquished *Alliance, terms []PeaceTerm) {
+	// NOTE: We need to remove the war first so it doesn't get removed again
+	// if the losing house gets destroyed.
+	Game.Wars = RemoveItem(Game.Wars, war)
+
+	victor := victors.Leader
+	vanquishedHouse := vanquished.Leader
+	victor.DiplomaticRelations[vanquishedHouse].ClearTension("made peace")
+	vanquishedHouse.DiplomaticRelations[victor].ClearTension("made peace")
+	ForgiveGrievances(victor, vanquishedHouse)
+
+	treaty := &Treaty{
+		Victor:     victor,
+		Vanquished: vanquishedHouse,
+		Terms:      terms,
+		StartCycle: Game.Cycle,
+		Hostages:   make([]*Knight, 0),
+	}
+	fmt.Printf(
+		"%s and %s signed a treaty until year %d, %s agreed to %s.\n",
+		victor.GetTitle(), vanquishedHouse.GetTitle(), treaty.GetEndCycle(),
+		vanquishedHouse.GetTitle(), GetTermsText(terms),
+	)
+
+	ExchangePrisoners(vanquished, victors)
+	if treaty.HasTerm(PrisonerExchange) {
+		ExchangePrisoners(victors, vanquished)
+	}
+
+	if treaty.HasTerm(LandCession) {
+		SettleOccupations(victors, vanquished, false)
+		CedeRegion(victor, vanquishedHouse)
+	} else {
+		ReturnOccupations(victors, vanquished)
+	}
+
+	// Sellswords make worthless hostages, only the house's own blood will do.
+	possibleHostages := GetSwornKnights(vanquishedHouse)
+	if treaty.HasTerm(Hostages) && len(possibleHostages) > 0 {
+		hostage := RandomSelect(possibleHostages)
+		CaptureKnight(victor, hostage)
+		treaty.Hostages = append(treaty.Hostages, hostage)
+		fmt.Printf("%s was sent to %s as a hostage.\n", hostage.GetTitle(), victor.GetTitle())
+	}
+
+	if treaty.HasTerm(Vassalage) {
+		SwearFealty(vanquishedHouse, victor)
+	}
+
+	Game.Treaties = append(Game.Treaties, treaty)
+
+	for _, house := range append(victors.GetHouses(), vanquished.GetHouses()...) {
+		FallIfLandless(house)
+	}
+	fmt.Printf("\n")
+}
+
+// EndTreaty releases a treaty's parties from its terms and sends the hostages home.
+func EndTreaty(treaty *Treaty) {
+	for _, hostage := range treaty.Hostages {
+		if Exists(Game.Knights, hostage) && hostage.Captor != nil {
+			fmt.Printf("%s returned home from being held hostage.\n", hostage.GetTitle())
+			ReleasePrisoner(hostage)
+		}
+	}
+	Game.Treaties = RemoveItem(Game.Treaties, treaty)
+}
+
+// BreakTreaty ends a treaty early because one of its parties went to war with
+// the other. The betrayed house is outraged, the rest of the realm learns not
+// to trust the oathbreaker, and any hostages pay for the broken oath.
+func BreakTreaty(treaty *Treaty, oathbreaker *House) {
+	betrayedTension := 6
+	realmTension := 2
+
+	betrayed := treaty.Victor
+	if betrayed == oathbreaker {
+		betrayed = treaty.Vanquished
+	}
+	fmt.Printf("%s broke its treaty with %s!\n", oathbreaker.GetTitle(), betrayed.GetTitle())
+
+	for _, house := range Game.Houses {
+		if house == oathbreaker {
+			continue
+		}
+		tensionIncrease := realmTension
+		if house == betrayed {
+			tensionIncrease = betrayedTension
+		}
+		house.DiplomaticRelations[oathbreaker].AddTension(
+			tensionIncrease, fmt.Sprintf("%s broke its treaty with %s", oathbreaker.GetTitle(), betrayed.GetTitle()),
+		)
+	}
+	fmt.Printf(
+		"The realm's tensions with %s increased by %d, %s's by %d.\n",
+		oathbreaker.GetTitle(), realmTension, betrayed.GetTitle(), betrayedTension,
+	)
+	RecordGrievance(betrayed, oathbreaker, Oathbreaking)
+
+	for _, hostage := range CopySlice(treaty.Hostages) {
+		if hostage.House == oathbreaker && Exists(Game.Knights, hostage) && hostage.Captor != nil {
+			treaty.Hostages = RemoveItem(treaty.Hostages, hostage)
+			ExecutePrisoner(hostage)
+		}
+	}
+	EndTreaty(treaty)
+}
+
+// UpholdTreaties collects the tribute owed under each treaty and ends the
+// treaties that have run their course.
+func UpholdTreaties() {
+	for _, treaty := range CopySlice(Game.Treaties) {
+		if treaty.HasTerm(Tribute) {
+			tribute := Min(treaty.GetTribute(), treaty.Vanquished.Coin)
+			treaty.Vanquished.Coin -= tribute
+			treaty.Victor.Coin += tribute
+			fmt.Printf(
+				"%s paid %d coin in tribute to %s.\n",
+				treaty.Vanquished.GetTitle(), tribute, treaty.Victor.GetTitle(),
+			)
+		}
+
+		if Game.Cycle >= treaty.GetEndCycle() {
+			fmt.Printf(
+				"The treaty between %s and %s has run its course.\n",
+				treaty.Victor.GetTitle(), treaty.Vanquished.GetTitle(),
+			)
+			EndTreaty(treaty)
+		}
+	}
+}
+
+// MediateWar sends the church's envoys to a war the house is leading, pushing
+// both sides to the negotiating table this year.
+func MediateWar(house *House) {
+	for _, war := range Game.Wars {
+		if war.Attackers.Leader != house && war.Defenders.Leader != house {
+			continue
+		}
+		if war.Mediated {
+			fmt.Printf("The Church is already mediating the war between %s and %s\n", war.Attackers.Leader.GetTitle(), war.Defenders.Leader.GetTitle())
+			return
+		}
+		if MediationCost > Game.Player.Coin {
+			fmt.Printf("Sending envoys costs %d coin, you only have %d.\n", MediationCost, Game.Player.Coin)
+			return
+		}
+
+		Game.Player.Coin -= MediationCost
+		war.Mediated = true
+		fmt.Printf(
+			"Your envoys will mediate between %s and %s, %d coin remaining\n",
+			war.Attackers.Leader.GetTitle(), war.Defenders.Leader.GetTitle(), Game.Player.Coin,
+		)
+		return
+	}
+	fmt.Printf("%s is not leading any wars\n", house.GetTitle())
+}
@@ -88,6 +88,33 @@ var BlueBackgroundCode = "\x1b[0044m"
 var RedBackgroundCode =  "\x1b[0041m"
 var DefaultColourCode =  "\x1b[0000m"
 
+// BannerColourCodes maps banner colours to the closest 256 colour terminal code.
+var BannerColourCodes = map[string]string{
+	"crimson":    "\x1b[38;5;160m",
+	"aqua":       "\x1b[38;5;51m",
+	"light grey": "\x1b[38;5;250m",
+	"dark grey":  "\x1b[38;5;240m",
+	// Black text would disappear on most terminals so give it a light background.
+	"black":      "\x1b[38;5;16;48;5;250m",
+	"white":      "\x1b[38;5;15m",
+	"pink":       "\x1b[38;5;218m",
+	"golden":     "\x1b[38;5;220m",
+	"yellow":     "\x1b[38;5;226m",
+	"blue":       "\x1b[38;5;21m",
+	"red":        "\x1b[38;5;196m",
+	"purple":     "\x1b[38;5;93m",
+	"turquoise":  "\x1b[38;5;44m",
+	"amber":      "\x1b[38;5;214m",
+	"violet":     "\x1b[38;5;177m",
+	"orange":     "\x1b[38;5;208m",
+	"navy":       "\x1b[38;5;18m",
+	"magenta":    "\x1b[38;5;201m",
+	"silver":     "\x1b[38;5;7m",
+	"copper":     "\x1b[38;5;166m",
+	"teal":       "\x1b[38;5;30m",
+	"green":      "\x1b[38;5;34m",
+}
+
 func ColouredText(colourCode string, text string) string {
 	return fmt.Sprintf("%s%s%s", colourCode, text, DefaultColourCode)
 }
@@ -0,0 +1,147 @@
+package game
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GetVassals returns the houses that have sworn fealty directly to the house.
+func GetVassals(liege *House) []*House {
+	vassals := make([]*House, 0)
+	for _, house := range Game.Houses {
+		if house.Liege == liege {
+			vassals = append(vassals, house)
+		}
+	}
+	return vassals
+}
+
+// IsSubjectOf returns whether the house owes fealty to the lord, either
+// directly or through its own liege.
+func IsSubjectOf(house *House, lord *House) bool {
+	for liege := house.Liege; liege != nil; liege = liege.Liege {
+		if liege == lord {
+			return true
+		}
+	}
+	return false
+}
+
+// IsFeudalBond returns whether one of the houses is the other's direct liege.
+func IsFeudalBond(house1 *House, house2 *House) bool {
+	return house1.Liege == house2 || house2.Liege == house1
+}
+
+// SwearFealty makes the vassal a subject of the liege. If the liege was itself
+// a subject of the vassal it is freed, a house can't be its own lord.
+func SwearFealty(vassal *House, liege *House) {
+	if IsSubjectOf(liege, vassal) {
+		fmt.Printf("%s threw off its fealty to %s.\n", liege.GetTitle(), liege.Liege.GetTitle())
+		liege.Liege = nil
+	}
+	vassal.Liege = liege
+	fmt.Printf("%s swore fealty to %s.\n", vassal.GetTitle(), liege.GetTitle())
+}
+
+// GetVassalTribute returns the coin a vassal owes its liege each year.
+func GetVassalTribute(vassal *House) int {
+	return Max(1, vassal.GetWealth() / 2)
+}
+
+// CollectVassalTribute has every vassal pay its liege. Handing over coin year
+// after year breeds resentment.
+func CollectVassalTribute() {
+	for _, vassal := range Game.Houses {
+		if vassal.Liege == nil {
+			continue
+		}
+
+		tribute := Min(GetVassalTribute(vassal), vassal.Coin)
+		vassal.Coin -= tribute
+		vassal.Liege.Coin += tribute
+		vassal.DiplomaticRelations[vassal.Liege].AddTension(1, fmt.Sprintf("paid %d coin in tribute", tribute))
+		fmt.Printf(
+			"%s paid %d coin in tribute to its liege %s, tensions increased to %d.\n",
+			vassal.GetTitle(), tribute, vassal.Liege.GetTitle(), vassal.DiplomaticRelations[vassal.Liege].GetTension(),
+		)
+	}
+}
+
+// CallVassals forces the vassals of every house in the alliance to join it,
+// along with their own vassals in turn. Vassals busy with wars of their own
+// can't answer the call.
+func (war *War) CallVassals(alliance *Alliance) {
+	for idx := 0; idx < len(alliance.GetHouses()); idx++ {
+		lord := alliance.GetHouses()[idx]
+		for _, vassal := range GetVassals(lord) {
+			if war.GetAlliance(vassal) != nil || vassal.NumWars() > 0 {
+				continue
+			}
+			alliance.Allies = append(alliance.Allies, vassal)
+			fmt.Printf("%s was called to war by its liege %s.\n", vassal.GetTitle(), lord.GetTitle())
+		}
+	}
+}
+
+// IsBoundToAlliance returns whether an ally is fighting for its liege. Vassals
+// have no say in their liege's wars, they can't withdraw or desert.
+func IsBoundToAlliance(ally *House, alliance *Alliance) bool {
+	return ally.Liege != nil && HouseIsInAlliance(alliance, ally.Liege)
+}
+
+// DoRebellions gives each vassal the chance to throw off its liege. Lieges
+// stretched thin by wars or outgrown by their vassals are the most likely to
+// face a rebellion.
+func DoRebellions() {
+	rebellionOb := 3
+
+	for _, vassal := range RandomizeOrder(Game.Houses) {
+		liege := vassal.Liege
+		if liege == nil || vassal.NumWars() > 0 {
+			continue
+		}
+
+		resentment := vassal.DiplomaticRelations[liege].GetTension() / 3
+		rebellionHits := RollHits(vassal.GetMight() + resentment)
+		loyaltyHits := RollHits(liege.GetAdjustedMight())
+		if rebellionHits < loyaltyHits + rebellionOb {
+			continue
+		}
+
+		fmt.Printf(
+			"%s rose up against its liege %s[%d vs %d]!\n",
+			vassal.GetTitle(), liege.GetTitle(), rebellionHits, loyaltyHits,
+		)
+		vassal.Liege = nil
+		if treaty := GetTreaty(vassal, liege); treaty != nil {
+			BreakTreaty(treaty, vassal)
+		}
+		war := CreateWar(vassal, liege, RecordGrievance(vassal, liege, Oppression))
+		Game.Wars = append(Game.Wars, war)
+	}
+}
+
+// DisplayHierarchy prints every independent house with its vassals beneath it.
+func DisplayHierarchy() {
+	for _, house := range Game.Houses {
+		if house.Liege == nil {
+			displayHierarchyBranch(house, "", "")
+		}
+	}
+	fmt.Printf("\n")
+}
+
+func displayHierarchyBranch(house *House, prefix string, childPrefix string) {
+	fmt.Printf(
+		"%s%s[might: %d, wealth: %d]\n",
+		prefix, house.GetTitle(), house.GetMight(), house.GetWealth(),
+	)
+	vassals := GetVassals(house)
+	for idx, vassal := range vassals {
+		if idx == len(vassals) - 1 {
+			displayHierarchyBranch(vassal, childPrefix + "└─ ", childPrefix + strings.Repeat(" ", 3))
+		} else {
+			displayHierarchyBranch(vassal, childPrefix + "├─ ", childPrefix + "│  ")
+		}
+	}
+}
@@ -9,7 +9,47 @@ type War struct {
 	Attackers *Alliance
 	Defenders *Alliance
 
+	// StartCycle is the year the war was declared.
+	StartCycle int
+	Sieges     []*Siege
+	MoraleHistory []*MoraleChange
+	LeaderChanges []*LeaderChange
+	// Mediated is set when the church sends envoys to push for peace this year.
+	Mediated bool
+	// CasusBelli is the grievance the attackers went to war over.
+	CasusBelli *Grievance
+	// Tyrannical is set when the attackers fell upon a house far weaker than themselves.
+	Tyrannical bool
+
 	attackingHouseIdx int
+	// defeatStreaks is the number of battles in a row each house has lost while defending.
+	defeatStreaks map[*House]int
+	// withdrawnHouses are the allies that have left the war of their own accord.
+	withdrawnHouses map[*House]bool
+}
+
+// GetEnemyAlliance returns the alliance fighting against the given one.
+func (war *War) GetEnemyAlliance(alliance *Alliance) *Alliance {
+	if alliance == war.Attackers {
+		return war.Defenders
+	}
+	return war.Attackers
+}
+
+// GetAlliance returns the side of the war the house is fighting on, or nil
+// if they aren't in the war.
+func (war *War) GetAlliance(house *House) *Alliance {
+	if HouseIsInAlliance(war.Attackers, house) {
+		return war.Attackers
+	} else if HouseIsInAlliance(war.Defenders, house) {
+		return war.Defenders
+	}
+	return nil
+}
+
+// GetLength returns how many years the war has been running.
+func (war *War) GetLength() int {
+	return Game.Cycle - war.StartCycle
 }
 
 type Alliance struct {
@@ -19,13 +59,20 @@ type Alliance struct {
 }
 
 func (alliance *Alliance) GetTotalMight() int {
-	totalMight := alliance.Leader.Might
+	totalMight := alliance.Leader.GetMight()
 	for _, ally := range alliance.Allies {
-		totalMight += ally.Might
+		totalMight += ally.GetMight()
 	}
 	return totalMight
 }
 
+// GetHouses returns the leader and allies of the alliance.
+func (alliance *Alliance) GetHouses() []*House {
+	houses := make([]*House, 0, len(alliance.Allies) + 1)
+	houses = append(houses, alliance.Leader)
+	return append(houses, alliance.Allies...)
+}
+
 func HouseIsInAlliance(alliance *Alliance, house *House) bool {
 	return house == alliance.Leader || Exists(alliance.Allies, house)
 }
@@ -42,21 +89,35 @@ func HousesAreAtWar(house1 *House, house2 *House) bool {
 	return false
 }
 
-func HouseWillJoinAlliance(allyHouse *House, alliance *Alliance, enemy *Alliance, otherEnemies []*House) bool {
+func HouseWillJoinAlliance(allyHouse *House, alliance *Alliance, enemy *Alliance, otherEnemies []*House, sympathy int) bool {
 	isEnemy := allyHouse == enemy.Leader
 	isLeaderHouse := allyHouse == alliance.Leader
 	isAlliedWithEnemy := Exists(enemy.Allies, allyHouse) || Exists(otherEnemies, allyHouse)
 	alreadyInWar := allyHouse.NumWars() > 0
-	// TODO: Check across all wars not just this one.
 	if isEnemy || isLeaderHouse || isAlliedWithEnemy || alreadyInWar {
 		return false
 	}
+	// Houses won't break a treaty or their oaths just to help someone else.
+	if GetTreaty(allyHouse, enemy.Leader) != nil || IsFeudalBond(allyHouse, enemy.Leader) {
+		return false
+	}
 
-	tensionWithTarget := allyHouse.DiplomaticRelations[enemy.Leader].Tension
-	tensionWithLeader := allyHouse.DiplomaticRelations[alliance.Leader].Tension
+	tensionWithTarget := allyHouse.DiplomaticRelations[enemy.Leader].GetTension()
+	tensionWithLeader := allyHouse.DiplomaticRelations[alliance.Leader].GetTension()
 	relativeTension := tensionWithTarget - tensionWithLeader
 
-	joinAlliancePool := int(math.Max(0, float64(relativeTension + allyHouse.Might)))
+	// Houses care less about wars far away from their lands.
+	distance := Min(GetHouseDistance(allyHouse, alliance.Leader), GetHouseDistance(allyHouse, enemy.Leader))
+	distancePenalty := Max(0, distance - 1)
+
+	// Honourable houses are swayed by the justice of the cause, while aggressive
+	// houses just like a fight.
+	personalityBonus := GetTraitModifier(allyHouse.Personality.Aggression) + sympathy * allyHouse.Personality.Honour / AverageTrait
+
+	// Houses are quick to stand against a tyrant.
+	infamyBonus := enemy.Leader.Infamy
+
+	joinAlliancePool := int(math.Max(0, float64(relativeTension + allyHouse.GetMight() - distancePenalty + personalityBonus + infamyBonus)))
 	joinAllianceHits := RollHits(joinAlliancePool)
 	willJoin := joinAllianceHits >= enemy.GetTotalMight()
 
@@ -70,23 +131,42 @@ func HouseWillJoinAlliance(allyHouse *House, alliance *Alliance, enemy *Alliance
 	return willJoin
 }
 
-func CreateWar(attackerHouse *House, defenderHouse *House) *War {
-	// TODO: Set morale based on some stat. Maybe median bravery?
+// CreateWar has the attacker declare war over a grievance it holds against
+// the defender. The grievance decides how eager the attackers are, how much
+// sympathy they find and what they can demand if they win.
+func CreateWar(attackerHouse *House, defenderHouse *House, casusBelli *Grievance) *War {
 	war := &War{
 		Attackers: &Alliance{
 			Leader: attackerHouse,
 			Allies: make([]*House, 0),
-			Morale: 6,
 		},
 		Defenders: &Alliance{
 			Leader: defenderHouse,
 			Allies: make([]*House, 0),
-			Morale: 6,
 		},
+		StartCycle: Game.Cycle,
+		Sieges: make([]*Siege, 0),
+		MoraleHistory: make([]*MoraleChange, 0),
+		LeaderChanges: make([]*LeaderChange, 0),
+		CasusBelli: casusBelli,
 		attackingHouseIdx: 0,
+		defeatStreaks: make(map[*House]int),
+		withdrawnHouses: make(map[*House]bool),
 	}
 
-	fmt.Printf("%s declared war against %s!\n", attackerHouse.GetTitle(), defenderHouse.GetTitle())
+	Game.WarHistory = append(Game.WarHistory, war)
+
+	fmt.Printf(
+		"%s declared war against %s over %s!\n",
+		attackerHouse.GetTitle(), defenderHouse.GetTitle(), casusBelli.GetDescription(),
+	)
+	if IsTyranny(attackerHouse, defenderHouse) {
+		war.Tyrannical = true
+		DenounceTyrant(attackerHouse, defenderHouse)
+	}
+	// Houses rally to a just cause and shun wars over petty slights.
+	attackerSympathy := casusBelli.Type.Sympathy
+	defenderSympathy := -casusBelli.Type.Sympathy
 
 	randomizedHouses := RandomizeOrder(Game.Houses)
 
@@ -99,7 +179,7 @@ func CreateWar(attackerHouse *House, defenderHouse *House) *War {
 
 		for ; attackerAllyIdx < len(Game.Houses); attackerAllyIdx++ {
 			allyHouse := randomizedHouses[attackerAllyIdx]
-			if HouseWillJoinAlliance(allyHouse, war.Attackers, war.Defenders, []*House{}) {
+			if HouseWillJoinAlliance(allyHouse, war.Attackers, war.Defenders, []*House{}, attackerSympathy) {
 				attackerAlly = allyHouse
 				attackerAllyIdx++
 				break
@@ -108,7 +188,7 @@ func CreateWar(attackerHouse *House, defenderHouse *House) *War {
 
 		for ; defenderAllyIdx < len(Game.Houses); defenderAllyIdx++ {
 			allyHouse := randomizedHouses[defenderAllyIdx]
-			if HouseWillJoinAlliance(allyHouse, war.Defenders, war.Attackers, []*House{attackerAlly}) {
+			if HouseWillJoinAlliance(allyHouse, war.Defenders, war.Attackers, []*House{attackerAlly}, defenderSympathy) {
 				defenderAlly = allyHouse
 				defenderAllyIdx++
 				break
@@ -123,6 +203,20 @@ func CreateWar(attackerHouse *House, defenderHouse *House) *War {
 			war.Defenders.Allies = append(war.Defenders.Allies, defenderAlly)
 		}
 	}
+	// Vassals have no choice in the matter.
+	war.CallVassals(war.Attackers)
+	war.CallVassals(war.Defenders)
+
+	// Morale is set once the alliances are known. Only the attackers have a
+	// grievance spurring them on, defenders are fighting because they must.
+	grievanceTension := attackerHouse.DiplomaticRelations[defenderHouse].GetTension()
+	war.Attackers.Morale = GetStartingMorale(war.Attackers, grievanceTension, casusBelli.Type.Zeal)
+	war.Defenders.Morale = GetStartingMorale(war.Defenders, 0, 0)
+	war.MoraleHistory = append(
+		war.MoraleHistory,
+		&MoraleChange{Cycle: Game.Cycle, Season: Game.Season, Alliance: war.Attackers, Reason: "war declared", Morale: war.Attackers.Morale},
+		&MoraleChange{Cycle: Game.Cycle, Season: Game.Season, Alliance: war.Defenders, Reason: "war declared", Morale: war.Defenders.Morale},
+	)
 	fmt.Printf("\n")
 
 	return war
@@ -130,126 +224,149 @@ func CreateWar(attackerHouse *House, defenderHouse *House) *War {
 
 func StartWars() {
 	for _, house := range RandomizeOrder(Game.Houses) {
-		// Don't start a war if we're already in one.
-		alreadyInWar := house.NumWars() > 0
-		if alreadyInWar {
-			continue
-		}
-
 		for targetHouse, relationship := range house.DiplomaticRelations {
-			tensionHits := RollHits(relationship.Tension)
+			// Vassals only turn on their lieges through rebellion.
+			if IsFeudalBond(house, targetHouse) {
+				continue
+			}
+			// Every war needs a cause.
+			casusBelli := GetCasusBelli(house, targetHouse)
+			if casusBelli == nil {
+				continue
+			}
+			// Don't start a second war against the same house.
+			if HousesAreAtWar(house, targetHouse) {
+				continue
+			}
 
-			// TODO: The ob should probably have another factor/be higher here, otherwise weak houses get trampled.
-			// TODO: Opponent might should be in relation to your might. Subtract or divide?
-			if tensionHits >= targetHouse.Might + 3 {
-				war := CreateWar(house, targetHouse)
+			decision := Game.WarPolicy.ConsiderWar(house, targetHouse, casusBelli)
+			relationship.LastWarDecision = decision
+			if decision.WillDeclare() {
+				fmt.Printf("%s\n", decision.GetExplanation())
+				if treaty := GetTreaty(house, targetHouse); treaty != nil {
+					BreakTreaty(treaty, house)
+				}
+				war := CreateWar(house, targetHouse, casusBelli)
 				Game.Wars = append(Game.Wars, war)
 			}
 		}
 	}
 }
 
+// GatherCoalition returns the house followed by any of its allies that answer
+// its call to battle. Mightier allies are more likely to have an army ready.
+// Besieged allies can't leave their castles to help.
+func (war *War) GatherCoalition(house *House, alliance *Alliance) []*House {
+	minJoinHits := 2
+
+	coalition := []*House{house}
+	for _, ally := range alliance.GetHouses() {
+		if ally == house || war.IsBesieged(ally) {
+			continue
+		}
+		if RollHits(ally.GetMight()) >= minJoinHits {
+			coalition = append(coalition, ally)
+		}
+	}
+	return coalition
+}
+
 func (war *War) DoNextBattles() {
 	/**
 	 * Wars are run by giving each house on both sides a chance to attack
 	 * a random house on the other side. A "turn" is one attack from each side.
 	 * If you attack your opponent and win you reduce their alliances morale by
-	 * the margin of the success.
+	 * the margin of the success. Houses under siege can't attack or be attacked
+	 * in the field, their fate is decided by the siege. Before the fighting
+	 * houses may join, leave or betray the alliances.
+	 * NOTE: Called once for every season the war's armies take the field, so
+	 * alliances, desertions and sieges move up to three times a year.
 	 */
-	allAttackers := make([]*House, 0, len(war.Attackers.Allies) + 1)
-	allAttackers = append(allAttackers, war.Attackers.Leader)
-	allAttackers = append(allAttackers, war.Attackers.Allies...)
+	war.ReevaluateAlliances()
+	war.DoDesertions()
+	war.DoSieges()
 
-	allDefenders := make([]*House, 0, len(war.Defenders.Allies) + 1)
-	allDefenders = append(allDefenders, war.Defenders.Leader)
-	allDefenders = append(allDefenders, war.Defenders.Allies...)
+	allAttackers := war.Attackers.GetHouses()
+	allDefenders := war.Defenders.GetHouses()
 
 	maxHouseIdx := Max[int](len(allAttackers), len(allDefenders))
 
 	// Every house on each side attacks a randome opponent. More allies means more attacks.
 	if war.attackingHouseIdx < len(allAttackers) {
-		attacker := allAttackers[war.attackingHouseIdx]
-		defender := RandomSelect(allDefenders)
-		attackerMargin := RunBattle(attacker, defender)
-		if attackerMargin > 0 {
-			war.Defenders.Morale -= attackerMargin
-			fmt.Printf(
-				"The morale of %s's alliance dropped to %d\n",
-				war.Defenders.Leader.GetTitle(), war.Defenders.Morale,
-			)
-		}
-		fmt.Printf("\n")
+		war.DoAttack(allAttackers[war.attackingHouseIdx], war.Attackers, war.Defenders)
 	}
-
 	if war.attackingHouseIdx < len(allDefenders) {
-		attacker := allDefenders[war.attackingHouseIdx]
-		defender := RandomSelect(allAttackers)
-		attackerMargin := RunBattle(attacker, defender)
-		if attackerMargin > 0 {
-			war.Attackers.Morale -= attackerMargin
-			fmt.Printf(
-				"The morale of %s's alliance is now at %d\n",
-				war.Attackers.Leader.GetTitle(), war.Attackers.Morale,
-			)
-		}
-		fmt.Printf("\n")
+		war.DoAttack(allDefenders[war.attackingHouseIdx], war.Defenders, war.Attackers)
 	}
 
 	war.attackingHouseIdx = (war.attackingHouseIdx + 1) % maxHouseIdx
 }
 
+// DoAttack has a house lead an attack against a random house on the enemy side
+// that is still in the field.
+func (war *War) DoAttack(attacker *House, attackingSide *Alliance, defendingSide *Alliance) {
+	if war.IsBesieged(attacker) {
+		return
+	}
+
+	possibleDefenders := make([]*House, 0)
+	for _, house := range defendingSide.GetHouses() {
+		if !war.IsBesieged(house) {
+			possibleDefenders = append(possibleDefenders, house)
+		}
+	}
+	if len(possibleDefenders) == 0 {
+		return
+	}
+
+	defender := RandomSelect(possibleDefenders)
+	report := RunBattle(
+		war.GatherCoalition(attacker, attackingSide), war.GatherCoalition(defender, defendingSide), 0,
+	)
+	war.ApplyBattleMorale(attackingSide, defendingSide, report)
+	war.RecordBattleResult(attacker, defender, report.AttackerMargin)
+	fmt.Printf("\n")
+}
+
 func (war *War) IsOver() bool {
 	return war.Attackers.Morale <= 0 || war.Defenders.Morale <= 0
 }
 
+// EndWar ends a war that one or both sides can no longer fight. A side that
+// collapses has no say in the terms, the victors take everything the war has
+// earned them and always some land.
 func (war *War) EndWar() {
 	if !war.IsOver() {
 		panic("tried to end war when it wasn't over.")
 	}
 
-	// NOTE: We need to remove the war first so it doesn't get removed again
-	// if the losing house gets destroyed.
-	Game.Wars = RemoveItem(Game.Wars, war)
-
-	attackLeader := war.Attackers.Leader
-	defenseLeader := war.Defenders.Leader
-
-	attackLeader.DiplomaticRelations[defenseLeader].Tension = 0
-	defenseLeader.DiplomaticRelations[attackLeader].Tension = 0
-
 	if war.Attackers.Morale <= 0 && war.Defenders.Morale <= 0 {
 		fmt.Printf(
 			"The war between %s and %s ended in a truce after significant losses on both sides. " +
-				"The might of both houses is reduced by 1.\n\n",
-			attackLeader.GetTitle(), defenseLeader.GetTitle(),
+				"Both sides keep the lands they occupy.\n",
+			war.Attackers.Leader.GetTitle(), war.Defenders.Leader.GetTitle(),
 		)
-		attackLeader.Might = Max[int](attackLeader.Might - 1, 1)
-		defenseLeader.Might = Max[int](defenseLeader.Might - 1, 1)
+		SettleOccupations(war.Attackers, war.Defenders, true)
+		war.MakePeace(war.Attackers, war.Defenders, []PeaceTerm{PrisonerExchange})
 	} else if war.Attackers.Morale <= 0 {
-		GiveWarRewards(war.Defenders, war.Attackers)
+		war.Surrender(war.Defenders, war.Attackers)
 	} else if war.Defenders.Morale <= 0 {
-		GiveWarRewards(war.Attackers, war.Defenders)
+		war.Surrender(war.Attackers, war.Defenders)
 	}
 }
 
-func GiveWarRewards(winner *Alliance, loser *Alliance) {
-	winner.Leader.Might = Min[int](winner.Leader.Might + 1, MaxMight)
-
-	// Destroy weak houses when they lose.
-	if loser.Leader.Might == 1 {
-		fmt.Printf(
-			"%s surrenders the war to %s. %s's might increases by 1. %s is crippled by the defeat and their house falls out of power.\n",
-			loser.Leader.GetTitle(), winner.Leader.GetTitle(), winner.Leader.GetTitle(), loser.Leader.GetTitle(),
-		)
-		DestroyHouse(loser.Leader)
-		newHouse := GenerateHouse()
-		fmt.Printf("%s rises to power.\n\n", newHouse.GetTitle())
-	} else {
-		fmt.Printf(
-			"%s surrenders the war to %s. %s's might increases by 1, %s's might decreases by 1.\n\n",
-			loser.Leader.GetTitle(), winner.Leader.GetTitle(),
-			winner.Leader.GetTitle(), loser.Leader.GetTitle(),
-		)
-		loser.Leader.Might = Max[int](loser.Leader.Might - 1, 1)
+func (war *War) Surrender(victors *Alliance, vanquished *Alliance) {
+	fmt.Printf("%s surrenders the war to %s.\n", vanquished.Leader.GetTitle(), victors.Leader.GetTitle())
+	minimumValue := GetTermsValue([]PeaceTerm{Tribute, Hostages, LandCession})
+	terms := ChooseTerms(Max(minimumValue, war.GetWarScore(victors)), war.GetClaim(victors))
+
+	// Rather than take a house's last land and see it fall, the victor takes
+	// its fealty instead.
+	if len(GetHeldRegions(vanquished.Leader)) <= 1 && Exists(terms, LandCession) {
+		terms = RemoveItem(terms, LandCession)
+		if !Exists(terms, Vassalage) {
+			terms = append(terms, Vassalage)
+		}
 	}
+	war.MakePeace(victors, vanquished, terms)
 }
@@ -4,30 +4,47 @@ import "fmt"
 
 type WorldEventFunc = func()
 
-func HouseAnnoysHouseEvent(flavourText string, tensionAmount int) {
-	sourceHouse := RandomSelect(Game.Houses)
+func HouseAnnoysHouseEvent(flavourText string, tensionAmount int, grievanceType *GrievanceType) (*House, *House) {
+	sourceHouse := SelectAntagonist(Game.Houses)
 	possibleTargets := RemoveItem(Game.Houses, sourceHouse)
 	targetHouse := RandomSelect(possibleTargets)
-	targetHouse.DiplomaticRelations[sourceHouse].Tension += tensionAmount
-	currentTension := targetHouse.DiplomaticRelations[sourceHouse].Tension
+	incidentText := fmt.Sprintf(flavourText, sourceHouse.GetTitle(), targetHouse.GetTitle())
+	targetHouse.DiplomaticRelations[sourceHouse].AddTension(tensionAmount, incidentText)
+	RecordGrievance(targetHouse, sourceHouse, grievanceType)
+	currentTension := targetHouse.DiplomaticRelations[sourceHouse].GetTension()
 	fmt.Printf(flavourText + " Tensions increased to %d.\n", sourceHouse.GetTitle(), targetHouse.GetTitle(), currentTension)
+	return sourceHouse, targetHouse
+}
+
+func TradeEmbargoEvent() {
+	sourceHouse, targetHouse := HouseAnnoysHouseEvent("%s imposed a trade embargo on %s.", 2, TradeDispute)
+	CancelTradeAgreement(sourceHouse, targetHouse)
 }
 
-// TODO: Maybe give houses a stat for how likely they are to antagonise others? Tyranny or something?
 // TODO: Make more personal events, knights killing other knights etc.
 var WorldEvents = []WorldEventFunc{
-	func() { HouseAnnoysHouseEvent("%s imposed a trade embargo on %s.", 2) },
-	func() { HouseAnnoysHouseEvent("%s raided a village in %s's lands.", 3) },
-	func() { HouseAnnoysHouseEvent("A %s noble offended a %s noble during a feast.", 1) },
-	func() { HouseAnnoysHouseEvent("A %s noble had a %s noble assassinated.", 3) },
-	func() { HouseAnnoysHouseEvent("%s is blackmailing %s.", 2) },
-	func() { HouseAnnoysHouseEvent("A %s noble killed a %s noble in a duel.", 2) },
-	func() { HouseAnnoysHouseEvent("A %s noble started a brawl with a %s noble during a feast.", 1) },
-	func() { HouseAnnoysHouseEvent("%s imposed tolls on all roads leading to %s's lands.", 1) },
-	func() { HouseAnnoysHouseEvent("%s deployed a garrison on %s's border.", 2) },
+	TradeEmbargoEvent,
+	func() { HouseAnnoysHouseEvent("%s raided a village in %s's lands.", 3, BorderRaid) },
+	func() { HouseAnnoysHouseEvent("A %s noble offended a %s noble during a feast.", 1, Insult) },
+	func() { HouseAnnoysHouseEvent("A %s noble had a %s noble assassinated.", 3, Assassination) },
+	func() { HouseAnnoysHouseEvent("%s is blackmailing %s.", 2, Blackmail) },
+	func() { HouseAnnoysHouseEvent("A %s noble killed a %s noble in a duel.", 2, SlainNoble) },
+	func() { HouseAnnoysHouseEvent("A %s noble started a brawl with a %s noble during a feast.", 1, Insult) },
+	func() { HouseAnnoysHouseEvent("%s imposed tolls on all roads leading to %s's lands.", 1, TradeDispute) },
+	func() { HouseAnnoysHouseEvent("%s deployed a garrison on %s's border.", 2, BorderProvocation) },
+	func() { HouseAnnoysHouseEvent("A %s noble broke off their betrothal to a %s noble.", 2, MarriageInsult) },
+	func() { CalamityEvent(Plague) },
+	func() { CalamityEvent(Famine) },
+	func() { CalamityEvent(Flood) },
+	func() { CalamityEvent(Fire) },
+	func() { CalamityEvent(GoodHarvest) },
 }
 
 func DoWorldEvent() {
+	// Houses can fall without being replaced, and it takes two to quarrel.
+	if len(Game.Houses) < 2 {
+		return
+	}
 	worldEvent := RandomSelect(WorldEvents)
 	worldEvent()
 }
\ No newline at end of file
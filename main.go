@@ -1,21 +1,37 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"knightmanager/game"
 	"knightmanager/names"
 	"math/rand"
+	"os"
 	"sort"
 	"time"
 )
 
-/**
-- Starting a war against a tiny house increases everyones tension? No one likes a tyrant.
- */
+// knightedHouseIdx is the next house to be given a new knight.
+var knightedHouseIdx int
 
 func main() {
+	simulateYears := flag.Int("simulate", 0, "simulate this many years without a player and print a summary of what happened")
+	simulateRuns := flag.Int("runs", 1, "how many games to simulate for each war policy")
+	warPolicyName := flag.String("policy", game.DefaultWarPolicy, "the war policy houses follow, or \"all\" to compare every policy in the simulator")
+	flag.Parse()
+
 	rand.Seed(time.Now().UnixNano())
 
+	if *simulateYears > 0 {
+		RunSimulations(*warPolicyName, *simulateYears, *simulateRuns)
+		return
+	}
+	warPolicy, found := game.WarPolicies[*warPolicyName]
+	if !found {
+		fmt.Printf("There is no war policy called %s.\n", *warPolicyName)
+		os.Exit(1)
+	}
+
 	fmt.Printf(
 		"The Church brings glory to the many gods by using it's resources " +
 		"to make the gods' values more prevalent in the world. Glory is brought " +
@@ -33,25 +49,18 @@ func main() {
 		game.ColouredText(game.RedBackgroundCode, "heretics"),
 	)
 
-	game.Game = &game.GameState{}
-	game.Game.Wars = make([]*game.War, 0)
-	game.Game.FemaleNameGenerator = names.NewSelectorNameGenerator("female_input_names.txt")
-	game.Game.MaleNameGenerator = names.NewSelectorNameGenerator("male_input_names.txt")
-	game.GenerateWorld()
+	NewGame(warPolicy)
 
-	game.Game.Player = &game.GloryBishop{
-		Coin: 30,
-		Glory: 0,
+	// The prophecy only concerns the knights of the great houses.
+	sortedKnights := make([]*game.Knight, 0, len(game.Game.Knights))
+	for _, knight := range game.Game.Knights {
+		if !knight.IsHedgeKnight() {
+			sortedKnights = append(sortedKnights, knight)
+		}
 	}
-
-	knightedHouseIdx := game.RandomRange(0, len(game.Game.Houses))
-	numNewKnightsPerSeason := 2
-
-
-	sortedKnights := game.CopySlice(game.Game.Knights)
 	sort.Slice(sortedKnights, func(x, y int) bool {
-		knightScore1 := sortedKnights[x].House.Might + sortedKnights[x].Prowess
-		knightScore2 := sortedKnights[y].House.Might + sortedKnights[y].Prowess
+		knightScore1 := sortedKnights[x].House.GetMight() + sortedKnights[x].Prowess
+		knightScore2 := sortedKnights[y].House.GetMight() + sortedKnights[y].Prowess
 		return knightScore1 < knightScore2
 	})
 
@@ -64,40 +73,7 @@ func main() {
 	sortedKnights[len(sortedKnights) - 3].ChurchObjective = game.Kill
 
 	for {
-		game.Game.Cycle++
-		game.DoPlayerTurn()
-
-		for idx := 0; idx < 3; idx++ {
-			game.DoWorldEvent()
-		}
-		fmt.Printf("\n")
-
-		for _, war := range game.CopySlice(game.Game.Wars) {
-			// If a house is destroyed in another war this turn any of their other wars.
-			// will end. We should only run battles for wars that are still going.
-			if game.Exists(game.Game.Wars, war) {
-				war.DoNextBattles()
-			}
-			if war.IsOver() {
-				war.EndWar()
-			}
-		}
-		if len(game.Game.Wars) > 0 {
-			fmt.Printf("\n")
-		}
-
-		game.CheckForNicknames()
-
-		// TODO: Only roll for start war after an insighting incident so every war has a cause?
-		game.StartWars()
-
-		// TODO: Roll house's wealth to see who gets knights?
-		// Round robin which houses get new knights.
-		for idx := 0; idx < numNewKnightsPerSeason; idx++ {
-			house := game.Game.Houses[knightedHouseIdx]
-			game.GenerateKnight(house)
-			knightedHouseIdx = (knightedHouseIdx + 1) % len(game.Game.Houses)
-		}
+		PlayYear(true)
 
 		numProtectedKnights := 0
 		numKillKnights := 0
@@ -123,3 +99,148 @@ func main() {
 		}
 	}
 }
+
+// NewGame generates a fresh realm whose houses follow the given war policy.
+func NewGame(warPolicy game.WarPolicy) {
+	game.Game = &game.GameState{}
+	game.Game.Wars = make([]*game.War, 0)
+	game.Game.WarHistory = make([]*game.War, 0)
+	game.Game.Tournaments = make([]*game.Tournament, 0)
+	game.Game.Treaties = make([]*game.Treaty, 0)
+	game.Game.TradeAgreements = make([]*game.TradeAgreement, 0)
+	game.Game.Calamities = make([]*game.Calamity, 0)
+	game.Game.WarPolicy = warPolicy
+	game.Game.FemaleNameGenerator = names.NewSelectorNameGenerator("female_input_names.txt")
+	game.Game.MaleNameGenerator = names.NewSelectorNameGenerator("male_input_names.txt")
+	game.GenerateWorld()
+
+	game.Game.Player = &game.GloryBishop{
+		Coin: 30,
+		Glory: 0,
+	}
+
+	knightedHouseIdx = game.RandomRange(0, len(game.Game.Houses))
+}
+
+// PlayYear runs the four seasons of a year. Without a player the church sits
+// the year out.
+func PlayYear(hasPlayer bool) {
+	game.Game.Cycle++
+	for _, season := range game.Seasons {
+		game.Game.Season = season
+		if hasPlayer {
+			game.DoPlayerTurn()
+		}
+
+		if season == game.Spring {
+			PlaySpring()
+		} else if season == game.Summer {
+			PlaySummer()
+		} else if season == game.Autumn {
+			PlayAutumn()
+		} else {
+			PlayWinter()
+		}
+	}
+}
+
+// PlaySpring raises fresh levies and holds the year's tournaments before the
+// armies march.
+func PlaySpring() {
+	game.RecruitLevies()
+	game.RunTournaments()
+	game.DoWorldEvent()
+	game.UpdateCalamities()
+	fmt.Printf("\n")
+
+	game.HireSellswords()
+	game.MercenariesSwitchSides()
+	FightCampaigns()
+}
+
+// PlaySummer is the height of the campaigning season.
+func PlaySummer() {
+	game.DoWorldEvent()
+	fmt.Printf("\n")
+	FightCampaigns()
+}
+
+// PlayAutumn brings in the harvest before the last battles of the year.
+func PlayAutumn() {
+	game.CollectHouseIncome()
+	game.CollectVassalTribute()
+	game.DoWorldEvent()
+	fmt.Printf("\n")
+	FightCampaigns()
+}
+
+// PlayWinter halts the fighting. Armies go home or freeze outside the walls
+// they besiege, and the houses settle their accounts and plot next year's wars.
+func PlayWinter() {
+	numNewKnightsPerYear := 2
+	// A new hedge knight wanders into the realm every few years.
+	hedgeKnightChance := 3
+
+	for _, war := range game.CopySlice(game.Game.Wars) {
+		war.DoWinterAttrition()
+		if war.IsOver() {
+			war.EndWar()
+		} else if game.Exists(game.Game.Wars, war) {
+			war.NegotiatePeace()
+		}
+	}
+	if len(game.Game.Wars) > 0 {
+		fmt.Printf("\n")
+	}
+
+	game.PayUpkeep()
+	game.ReleaseSellswords()
+	game.UpgradeCastles()
+	game.ResolvePrisoners()
+	game.UpholdTreaties()
+	game.UpholdTradeAgreements()
+	game.FormTradeAgreements()
+	game.CheckForNicknames()
+	game.CoolTensions()
+
+	game.ResolveSuccessionDisputes()
+	game.DoRisings()
+	game.DoRebellions()
+	game.StartWars()
+
+	// TODO: Roll house's wealth to see who gets knights?
+	// Round robin which houses get new knights.
+	for idx := 0; idx < numNewKnightsPerYear; idx++ {
+		// Houses can fall without being replaced, so there may be no one left
+		// to knight, and the index must be wrapped before using it.
+		if len(game.Game.Houses) == 0 {
+			break
+		}
+		knightedHouseIdx = knightedHouseIdx % len(game.Game.Houses)
+		house := game.Game.Houses[knightedHouseIdx]
+		game.GenerateKnight(house)
+		knightedHouseIdx = (knightedHouseIdx + 1) % len(game.Game.Houses)
+	}
+	if game.RandomRange(0, hedgeKnightChance) == 0 {
+		hedgeKnight := game.GenerateKnight(nil)
+		fmt.Printf("%s wandered into the realm looking for work.\n\n", hedgeKnight.GetTitle())
+	}
+}
+
+// FightCampaigns runs a round of battles in every war whose armies are in the field.
+func FightCampaigns() {
+	for _, war := range game.CopySlice(game.Game.Wars) {
+		// If a house is destroyed in another war this turn any of their other wars.
+		// will end. We should only run battles for wars that are still going.
+		if !game.Exists(game.Game.Wars, war) || !game.IsCampaigning() {
+			continue
+		}
+		war.DoNextBattles()
+		if war.IsOver() {
+			war.EndWar()
+		}
+	}
+	if len(game.Game.Wars) > 0 {
+		fmt.Printf("\n")
+	}
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"fmt"
+	"knightmanager/game"
+	"os"
+	"sort"
+)
+
+// SimulationSummary is what happened to the realm over the simulated games
+// played with a single war policy.
+type SimulationSummary struct {
+	PolicyName string
+	Runs       int
+	Years      int
+
+	WarsDeclared   int
+	Rebellions     int
+	TyrannicalWars int
+	// WarYears is the number of wars being fought each year, added up.
+	WarYears       int
+	HousesStanding int
+	MightiestShare int
+}
+
+// RunSimulations plays games without a player for each policy requested and
+// prints how the realm fared under them. All the usual narration is silenced.
+func RunSimulations(policyName string, years int, runs int) {
+	policyNames := make([]string, 0)
+	if policyName == "all" {
+		for name := range game.WarPolicies {
+			policyNames = append(policyNames, name)
+		}
+		sort.Strings(policyNames)
+	} else if _, found := game.WarPolicies[policyName]; found {
+		policyNames = append(policyNames, policyName)
+	} else {
+		fmt.Printf("There is no war policy called %s.\n", policyName)
+		os.Exit(1)
+	}
+
+	summaries := make([]*SimulationSummary, 0, len(policyNames))
+	for _, name := range policyNames {
+		summary := &SimulationSummary{
+			PolicyName: name,
+			Runs:       runs,
+			Years:      years,
+		}
+		for run := 0; run < runs; run++ {
+			SimulateGame(game.WarPolicies[name], years, summary)
+		}
+		summaries = append(summaries, summary)
+	}
+
+	fmt.Printf("Simulated %d game(s) of %d years for each war policy, averaged per game:\n", runs, years)
+	for _, summary := range summaries {
+		summary.Display()
+	}
+}
+
+// SimulateGame plays a single game with stdout silenced and adds what
+// happened to the summary.
+func SimulateGame(warPolicy game.WarPolicy, years int, summary *SimulationSummary) {
+	stdout := os.Stdout
+	devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		panic(err.Error())
+	}
+	defer devNull.Close()
+	os.Stdout = devNull
+	defer func() { os.Stdout = stdout }()
+
+	NewGame(warPolicy)
+	for year := 0; year < years; year++ {
+		PlayYear(false)
+		summary.WarYears += len(game.Game.Wars)
+	}
+
+	for _, war := range game.Game.WarHistory {
+		summary.WarsDeclared++
+		if war.CasusBelli.Type == game.Oppression {
+			summary.Rebellions++
+		}
+		if war.Tyrannical {
+			summary.TyrannicalWars++
+		}
+	}
+	summary.HousesStanding += len(game.Game.Houses)
+	mightiestRegions := 0
+	totalRegions := 0
+	for _, house := range game.Game.Houses {
+		numRegions := len(game.GetHeldRegions(house))
+		mightiestRegions = game.Max(mightiestRegions, numRegions)
+		totalRegions += numRegions
+	}
+	if totalRegions > 0 {
+		summary.MightiestShare += 100 * mightiestRegions / totalRegions
+	}
+}
+
+func (summary *SimulationSummary) Display() {
+	perRun := func(total int) float64 {
+		return float64(total) / float64(summary.Runs)
+	}
+	fmt.Printf(
+		"%s: %.1f wars declared(%.1f rebellions, %.1f against far weaker houses), %.1f war years, " +
+		"%.1f houses standing, largest house held %.0f%% of the land\n",
+		summary.PolicyName, perRun(summary.WarsDeclared), perRun(summary.Rebellions), perRun(summary.TyrannicalWars),
+		perRun(summary.WarYears), perRun(summary.HousesStanding), perRun(summary.MightiestShare),
+	)
+}